@@ -8,10 +8,14 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
 	"github.com/austinkempa/dcc-character-sheet/internal/models"
+	"github.com/austinkempa/dcc-character-sheet/internal/rules"
 	"github.com/austinkempa/dcc-character-sheet/internal/storage"
 )
 
@@ -19,6 +23,10 @@ import (
 type App struct {
 	ctx     context.Context
 	storage *storage.Storage
+	roller  *dice.Roller
+	catalog *catalog.Catalog
+
+	catalogError error // Why the catalog override last failed to load, if it did
 }
 
 // NewApp creates a new App application struct
@@ -30,6 +38,12 @@ func NewApp() *App {
 func (a *App) startup(ctx context.Context) {
 	a.ctx = ctx
 	a.storage = storage.NewStorage()
+	a.roller = dice.NewRoller()
+
+	// A broken override falls back to the defaults; GetCatalogError reports why
+	if err := a.ReloadCatalog(); err != nil {
+		a.catalog, _ = catalog.Default()
+	}
 }
 
 // domReady is called after front-end resources have been loaded
@@ -63,165 +77,2126 @@ func (a *App) GetDeletedCharacters() ([]*models.Character, error) {
 	return a.storage.GetDeletedCharacters()
 }
 
+// SaveCharacter saves a character and records its changes. When the house
+// rules ask for strict saves, characters with validation errors are rejected.
+// Game actions (rolls, rest, combat, loot) save directly and are not checked.
 func (a *App) SaveCharacter(character *models.Character, note string) error {
+	houseRules, err := a.storage.GetHouseRules()
+	if err != nil {
+		return err
+	}
+
+	if houseRules.StrictSaves && character.IsActive {
+		result := rules.ValidateCharacter(a.catalog, character, houseRules)
+		if !result.Valid {
+			first := result.Errors[0]
+			return fmt.Errorf("%s: %s (%d error(s))", first.Field, first.Message, len(result.Errors))
+		}
+	}
+
 	return a.storage.SaveCharacter(character, note)
 }
 
-func (a *App) AddHistoryNote(id string, note string) error {
-	return a.storage.AddHistoryNote(id, note)
+// ValidateCharacter checks a character against the rules and the house rules profile
+func (a *App) ValidateCharacter(character *models.Character) (*models.ValidationResult, error) {
+	houseRules, err := a.storage.GetHouseRules()
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.ValidateCharacter(a.catalog, character, houseRules), nil
 }
 
-func (a *App) DeleteCharacter(id string) error {
-	return a.storage.DeleteCharacter(id)
+// GetHouseRules returns the house rules profile used by validation
+func (a *App) GetHouseRules() (*models.HouseRules, error) {
+	return a.storage.GetHouseRules()
 }
 
-func (a *App) RestoreCharacter(id string) error {
-	return a.storage.RestoreCharacter(id)
+// SaveHouseRules replaces the house rules profile
+func (a *App) SaveHouseRules(houseRules *models.HouseRules) error {
+	if houseRules.MaxAttributeScore < 0 || houseRules.MaxLevel < 0 {
+		return fmt.Errorf("house rule limits cannot be negative")
+	}
+	return a.storage.SaveHouseRules(houseRules)
 }
 
-func (a *App) ExportHistory(id string) (string, error) {
-	character, err := a.storage.GetCharacter(id)
-	if err != nil {
-		return "", err
+// GenerateFunnelCharacters rolls up and saves a batch of zero-level funnel characters
+func (a *App) GenerateFunnelCharacters(count int, options models.FunnelOptions) ([]*models.Character, error) {
+	if count < 1 {
+		return nil, fmt.Errorf("count must be at least 1")
 	}
 
-	filename, err := a.storage.ExportHistory(character)
+	prefix := options.NamePrefix
+	if prefix == "" {
+		prefix = "Peasant"
+	}
+
+	var party *models.Party
+	if options.PartyID != "" {
+		var err error
+		party, err = a.storage.GetParty(options.PartyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	base := time.Now().UnixNano()
+	var characters []*models.Character
+	var ids []string
+	for i := 0; i < count; i++ {
+		alignment := options.Alignment
+		if options.RandomAlignment {
+			alignment = a.roller.RollDie(3) - 1
+		}
+
+		id := fmt.Sprintf("character-%d", base+int64(i))
+		name := fmt.Sprintf("%s %d", prefix, i+1)
+
+		character, err := rules.GenerateFunnelCharacter(a.roller, a.catalog, id, name, alignment)
+		if err != nil {
+			return nil, err
+		}
+		characters = append(characters, character)
+		ids = append(ids, id)
+	}
+
+	var partyIds []string
+	if party != nil {
+		partyIds = append(partyIds, party.ID)
+	}
+
+	// The batch is saved as a whole so a failure doesn't leave half a funnel
+	err := a.storage.Transaction(ids, partyIds, func() error {
+		for _, character := range characters {
+			if err := a.storage.SaveCharacter(character, "Character created (funnel)"); err != nil {
+				return err
+			}
+		}
+		if party == nil {
+			return nil
+		}
+
+		party.CharacterIDs = append(party.CharacterIDs, ids...)
+		a.syncPartyMembers(party)
+		party.UpdatedAt = time.Now()
+		return a.storage.SavePartyWithNote(party, "Funnel characters joined")
+	})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return fmt.Sprintf("History exported to: %s", filename), nil
+	return characters, nil
 }
 
-// Map management methods
+// LevelUp advances one of a character's classes by a level and records a single history entry
+func (a *App) LevelUp(id string, classId string) (*models.LevelUpResult, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
 
-func (a *App) CreateMap(name string, gridWidth, gridHeight, gridSize int) (string, error) {
-	id := fmt.Sprintf("map-%d", time.Now().Unix())
+	result, err := rules.LevelUp(a.roller, a.catalog, character, classId)
+	if err != nil {
+		return nil, err
+	}
 
-	mapData := &models.Map{
-		ID:         id,
-		Name:       name,
-		GridWidth:  gridWidth,
-		GridHeight: gridHeight,
-		GridSize:   gridSize,
-		GridColor:  "#cccccc",
-		ShowGrid:   true,
-		IsActive:   true,
-		Strokes:    json.RawMessage(`{}`),
-		Icons:      []models.MapIcon{},
-		Background: nil,
+	note := fmt.Sprintf("Level up: %s %d (rolled %d on %s, +%d HP)", result.ClassName, result.Level, result.HitPointRoll, result.HitDie, result.HitPointsGained)
+	if result.DeedDie != "" {
+		note += fmt.Sprintf(", deed die %s", result.DeedDie)
 	}
 
-	if err := a.storage.SaveMap(mapData); err != nil {
-		return "", err
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
 	}
 
-	return id, nil
+	return result, nil
 }
 
-func (a *App) GetMap(id string) (*models.Map, error) {
-	return a.storage.GetMap(id)
-}
+// AwardExperience gives XP to each character, keeping the experience fields
+// consistent, and reports who is now eligible to level up
+func (a *App) AwardExperience(ids []string, amount int, reason string) ([]models.ExperienceStatus, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("XP award must be positive")
+	}
 
-func (a *App) GetMaps() ([]*models.Map, error) {
-	return a.storage.GetMaps()
+	note := fmt.Sprintf("Awarded %d XP", amount)
+	if reason != "" {
+		note += ": " + reason
+	}
+
+	statuses := []models.ExperienceStatus{}
+	for _, id := range ids {
+		character, err := a.storage.GetCharacter(id)
+		if err != nil {
+			return statuses, err
+		}
+
+		if err := rules.AwardExperience(character, amount); err != nil {
+			return statuses, err
+		}
+
+		if err := a.storage.SaveCharacter(character, note); err != nil {
+			return statuses, err
+		}
+
+		statuses = append(statuses, rules.ExperienceStatus(character))
+	}
+
+	return statuses, nil
 }
 
-func (a *App) GetDeletedMaps() ([]*models.Map, error) {
-	return a.storage.GetDeletedMaps()
+// AwardPartyExperience gives XP to a party's living, present members, either
+// the full amount each or split between them, and saves everyone together
+// with one history entry each, tagged with the session
+func (a *App) AwardPartyExperience(partyId string, amount int, options models.PartyExperienceOptions) (*models.PartyExperienceResult, error) {
+	members, err := a.storage.GetPartyCharacters(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients, result, err := rules.PlanPartyExperience(partyId, members, amount, options)
+	if err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Awarded %d XP (session %s)", result.AmountEach, result.Session)
+	if result.FunnelRule {
+		note += ", equal XP for funnel survivors"
+	}
+	if options.Reason != "" {
+		note += ": " + options.Reason
+	}
+
+	var ids []string
+	for _, character := range recipients {
+		if err := rules.AwardExperience(character, result.AmountEach); err != nil {
+			return nil, err
+		}
+		result.Awarded = append(result.Awarded, rules.ExperienceStatus(character))
+		ids = append(ids, character.ID)
+	}
+
+	link := &models.HistoryLink{ID: fmt.Sprintf("xp-%d", time.Now().UnixNano()), PartyID: partyId, Session: result.Session}
+	err = a.storage.Transaction(ids, nil, func() error {
+		for _, character := range recipients {
+			if err := a.storage.SaveLinkedCharacter(character, note, link); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-func (a *App) SaveMap(mapData *models.Map) error {
-	return a.storage.SaveMap(mapData)
+// GetExperienceStatus returns a character's progress toward their next level
+func (a *App) GetExperienceStatus(id string) (*models.ExperienceStatus, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	rules.SyncExperience(character)
+	status := rules.ExperienceStatus(character)
+	return &status, nil
 }
 
-func (a *App) DeleteMap(id string) error {
-	return a.storage.DeleteMap(id)
+// GetLevelUpCandidates returns every active character with enough XP to level up
+func (a *App) GetLevelUpCandidates() ([]models.ExperienceStatus, error) {
+	characters, err := a.storage.GetCharacters()
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := []models.ExperienceStatus{}
+	for _, character := range characters {
+		if rules.CanLevelUp(character) {
+			rules.SyncExperience(character)
+			candidates = append(candidates, rules.ExperienceStatus(character))
+		}
+	}
+
+	return candidates, nil
 }
 
-func (a *App) RestoreMap(id string) error {
-	return a.storage.RestoreMap(id)
+// BurnLuck permanently spends a character's Luck for a bonus and records it in their luck log
+func (a *App) BurnLuck(id string, amount int, reason string) (*models.LuckBurnResult, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := rules.BurnLuck(a.roller, character, amount, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Burned %d Luck for +%d", amount, result.Bonus)
+	if reason != "" {
+		note += ": " + reason
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-func (a *App) ClearMap(id string) error {
-	return a.storage.ClearMap(id)
+// RegenerateLuck restores a night's worth of burned Luck for a thief or halfling
+func (a *App) RegenerateLuck(id string) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := rules.RegenerateLuck(character)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount > 0 {
+		if err := a.storage.SaveCharacter(character, fmt.Sprintf("Regenerated %d Luck", amount)); err != nil {
+			return nil, err
+		}
+	}
+
+	return character, nil
 }
 
-// World notes management methods
+// Spellburn burns a wizard's or elf's Strength, Agility and Stamina for a spell check bonus
+func (a *App) Spellburn(id string, allocations models.SpellburnAllocation) (*models.SpellburnResult, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
 
-func (a *App) GetWorldNote(id string) (*models.WorldNote, error) {
-	return a.storage.GetWorldNote(id)
+	result, err := rules.Spellburn(character, allocations)
+	if err != nil {
+		return nil, err
+	}
+
+	var burned []string
+	for _, burn := range []struct {
+		name   string
+		amount int
+	}{
+		{"Str", allocations.Strength},
+		{"Agi", allocations.Agility},
+		{"Sta", allocations.Stamina},
+	} {
+		if burn.amount > 0 {
+			burned = append(burned, fmt.Sprintf("%d %s", burn.amount, burn.name))
+		}
+	}
+
+	note := fmt.Sprintf("Spellburned %s for +%d", strings.Join(burned, ", "), result.Bonus)
+	if allocations.Reason != "" {
+		note += ": " + allocations.Reason
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
-func (a *App) GetWorldNotes() ([]*models.WorldNote, error) {
-	return a.storage.GetWorldNotes()
+// RecoverSpellburn heals a character's outstanding spellburn by 1 point per attribute per day of rest
+func (a *App) RecoverSpellburn(id string, days int) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	healed := rules.RecoverSpellburn(character, days)
+	if healed > 0 {
+		note := fmt.Sprintf("Rested %d day(s), healed %d spellburn", days, healed)
+		if err := a.storage.SaveCharacter(character, note); err != nil {
+			return nil, err
+		}
+	}
+
+	return character, nil
 }
 
-func (a *App) GetDeletedWorldNotes() ([]*models.WorldNote, error) {
-	return a.storage.GetDeletedWorldNotes()
+// AdjustPurse adds coins to a character's purse, or spends them with negative
+// amounts, making change from other coins when needed
+func (a *App) AdjustPurse(id string, delta models.Purse) (*models.Purse, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rules.AdjustPurse(&character.Purse, delta); err != nil {
+		return nil, err
+	}
+
+	var gained, spent models.Purse
+	gained.PP, spent.PP = splitDelta(delta.PP)
+	gained.EP, spent.EP = splitDelta(delta.EP)
+	gained.GP, spent.GP = splitDelta(delta.GP)
+	gained.SP, spent.SP = splitDelta(delta.SP)
+	gained.CP, spent.CP = splitDelta(delta.CP)
+
+	var parts []string
+	if gained != (models.Purse{}) {
+		parts = append(parts, "received "+rules.FormatPurse(gained))
+	}
+	if spent != (models.Purse{}) {
+		parts = append(parts, "spent "+rules.FormatPurse(spent))
+	}
+	note := "Purse adjusted"
+	if len(parts) > 0 {
+		note += ": " + strings.Join(parts, ", ")
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+
+	return &character.Purse, nil
 }
 
-func (a *App) SaveWorldNote(note *models.WorldNote) error {
-	return a.storage.SaveWorldNote(note)
+// splitDelta separates a coin delta into the amount gained and the amount spent
+func splitDelta(amount int) (int, int) {
+	if amount < 0 {
+		return 0, -amount
+	}
+	return amount, 0
 }
 
-func (a *App) DeleteWorldNote(id string) error {
-	return a.storage.DeleteWorldNote(id)
+// GetWealth totals the value of a character's coins and equipment in gold pieces
+func (a *App) GetWealth(id string) (*models.Wealth, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	wealth := rules.Wealth(character)
+	return &wealth, nil
 }
 
-func (a *App) RestoreWorldNote(id string) error {
-	return a.storage.RestoreWorldNote(id)
+// GetDerivedStats returns a character's calculated totals, including outstanding spellburn
+func (a *App) GetDerivedStats(id string) (*models.DerivedStats, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.DerivedStats(a.catalog, character), nil
 }
 
-// Party management methods
+// AddCondition applies a condition such as poison or a curse to a character
+func (a *App) AddCondition(characterId string, condition models.Condition) (*models.Condition, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
 
-func (a *App) CreateParty(name string, description string, characterIds []string) (string, error) {
-	id := fmt.Sprintf("party-%d", time.Now().Unix())
+	applied, err := rules.ApplyCondition(character, condition)
+	if err != nil {
+		return nil, err
+	}
 
-	party := &models.Party{
-		ID:           id,
-		Name:         name,
-		Description:  description,
-		CharacterIDs: characterIds,
-		IsActive:     true,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+	note := "Until removed"
+	if applied.Duration.Value > 0 {
+		note = fmt.Sprintf("Lasts %d %s", applied.Duration.Value, applied.Duration.Unit)
 	}
 
-	if err := a.storage.SaveParty(party); err != nil {
-		return "", err
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
 	}
 
-	return id, nil
+	return applied, nil
 }
 
-func (a *App) GetParty(id string) (*models.Party, error) {
-	return a.storage.GetParty(id)
-}
+// RemoveCondition ends a condition before its duration runs out
+func (a *App) RemoveCondition(characterId string, conditionId string) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
 
-func (a *App) GetParties() ([]*models.Party, error) {
-	return a.storage.GetParties()
-}
+	removed, err := rules.RemoveCondition(character, conditionId)
+	if err != nil {
+		return nil, err
+	}
 
-func (a *App) GetDeletedParties() ([]*models.Party, error) {
-	return a.storage.GetDeletedParties()
-}
+	if err := a.storage.SaveCharacter(character, fmt.Sprintf("%s removed early", removed.Name)); err != nil {
+		return nil, err
+	}
 
-func (a *App) SaveParty(party *models.Party) error {
-	party.UpdatedAt = time.Now()
-	return a.storage.SaveParty(party)
+	return character, nil
 }
 
-func (a *App) DeleteParty(id string) error {
-	return a.storage.DeleteParty(id)
+// GetLuckLog returns a character's Luck burns and regenerations
+func (a *App) GetLuckLog(id string) ([]models.LuckEvent, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if character.LuckLog == nil {
+		return []models.LuckEvent{}, nil
+	}
+	return character.LuckLog, nil
 }
 
-func (a *App) RestoreParty(id string) error {
-	return a.storage.RestoreParty(id)
+// StartDying drops a character to 0 HP. Level 0 characters die outright;
+// others start bleeding out.
+func (a *App) StartDying(id string, cause string) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rules.StartDying(character, cause); err != nil {
+		return nil, err
+	}
+
+	note := "Dropped to 0 HP"
+	if cause != "" {
+		note += ": " + cause
+	}
+	if character.Life.State == models.LifeStateDying {
+		note += fmt.Sprintf(" (%d round(s) to bleed out)", character.Life.RoundsRemaining)
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+
+	return character, nil
 }
 
-func (a *App) GetPartyCharacters(partyId string) ([]*models.Character, error) {
-	return a.storage.GetPartyCharacters(partyId)
+// KillCharacter marks a character as dead. Dead characters stay on the
+// character list and appear in the graveyard until they are deleted.
+func (a *App) KillCharacter(id string, cause string) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if character.Life.State == models.LifeStateDead {
+		return nil, fmt.Errorf("%s is already dead", character.Name)
+	}
+
+	rules.Kill(character, cause)
+	if err := a.storage.SaveCharacter(character, "Died: "+character.Life.Cause); err != nil {
+		return nil, err
+	}
+
+	return character, nil
+}
+
+// StabilizeCharacter heals a dying character before they bleed out
+func (a *App) StabilizeCharacter(id string, hitPoints int) (*models.Character, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	stamina := character.Stamina.Base
+	if err := rules.Stabilize(character, hitPoints); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Stabilized while bleeding out at %d HP", character.CurrentHealth)
+	if lost := stamina - character.Stamina.Base; lost > 0 {
+		note += fmt.Sprintf(", scarred (-%d Stamina)", lost)
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+
+	return character, nil
+}
+
+// RecoverBody rolls a Luck check for a dead character whose body is reached within an hour
+func (a *App) RecoverBody(id string) (*models.BodyRecoveryResult, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := rules.RecoverBody(a.roller, character)
+	if err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Recovered the body: rolled %d against Luck %d, failed", result.Roll, result.Luck)
+	if result.Success {
+		note = fmt.Sprintf("Recovered the body: rolled %d against Luck %d, survived with -1 %s", result.Roll, result.Luck, result.StatLoss)
+	}
+
+	if err := a.storage.SaveCharacter(character, note); err != nil {
+		return nil, err
+	}
+	if !result.Success {
+		// Only the body roll changed, which the change detector doesn't report
+		if err := a.storage.AddHistoryNote(id, note); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// GetGraveyard returns dead characters that haven't been deleted, most recent death first
+func (a *App) GetGraveyard() ([]models.GraveyardEntry, error) {
+	characters, err := a.storage.GetCharacters()
+	if err != nil {
+		return nil, err
+	}
+
+	graveyard := []models.GraveyardEntry{}
+	for _, character := range characters {
+		if rules.LifeState(character) == models.LifeStateDead {
+			graveyard = append(graveyard, rules.GraveyardEntry(character))
+		}
+	}
+
+	sort.Slice(graveyard, func(i, j int) bool {
+		return graveyard[i].DiedAt.After(graveyard[j].DiedAt)
+	})
+
+	return graveyard, nil
+}
+
+func (a *App) AddHistoryNote(id string, note string) error {
+	return a.storage.AddHistoryNote(id, note)
+}
+
+func (a *App) DeleteCharacter(id string) error {
+	return a.storage.DeleteCharacter(id)
+}
+
+func (a *App) RestoreCharacter(id string) error {
+	return a.storage.RestoreCharacter(id)
+}
+
+func (a *App) ExportHistory(id string) (string, error) {
+	character, err := a.storage.GetCharacter(id)
+	if err != nil {
+		return "", err
+	}
+
+	filename, err := a.storage.ExportHistory(character)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("History exported to: %s", filename), nil
+}
+
+// Map management methods
+
+func (a *App) CreateMap(name string, gridWidth, gridHeight, gridSize int) (string, error) {
+	id := fmt.Sprintf("map-%d", time.Now().Unix())
+
+	mapData := &models.Map{
+		ID:         id,
+		Name:       name,
+		GridWidth:  gridWidth,
+		GridHeight: gridHeight,
+		GridSize:   gridSize,
+		GridColor:  "#cccccc",
+		ShowGrid:   true,
+		IsActive:   true,
+		Strokes:    json.RawMessage(`{}`),
+		Icons:      []models.MapIcon{},
+		Background: nil,
+	}
+
+	if err := a.storage.SaveMap(mapData); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (a *App) GetMap(id string) (*models.Map, error) {
+	return a.storage.GetMap(id)
+}
+
+func (a *App) GetMaps() ([]*models.Map, error) {
+	return a.storage.GetMaps()
+}
+
+func (a *App) GetDeletedMaps() ([]*models.Map, error) {
+	return a.storage.GetDeletedMaps()
+}
+
+func (a *App) SaveMap(mapData *models.Map) error {
+	return a.storage.SaveMap(mapData)
+}
+
+func (a *App) DeleteMap(id string) error {
+	return a.storage.DeleteMap(id)
+}
+
+func (a *App) RestoreMap(id string) error {
+	return a.storage.RestoreMap(id)
+}
+
+func (a *App) ClearMap(id string) error {
+	return a.storage.ClearMap(id)
+}
+
+// World notes management methods
+
+func (a *App) GetWorldNote(id string) (*models.WorldNote, error) {
+	return a.storage.GetWorldNote(id)
+}
+
+func (a *App) GetWorldNotes() ([]*models.WorldNote, error) {
+	return a.storage.GetWorldNotes()
+}
+
+func (a *App) GetDeletedWorldNotes() ([]*models.WorldNote, error) {
+	return a.storage.GetDeletedWorldNotes()
+}
+
+func (a *App) SaveWorldNote(note *models.WorldNote) error {
+	return a.storage.SaveWorldNote(note)
+}
+
+func (a *App) DeleteWorldNote(id string) error {
+	return a.storage.DeleteWorldNote(id)
+}
+
+func (a *App) RestoreWorldNote(id string) error {
+	return a.storage.RestoreWorldNote(id)
+}
+
+// Creature methods
+
+// CreateCreature saves a new monster or NPC stat block
+func (a *App) CreateCreature(creature *models.Creature) (*models.Creature, error) {
+	if strings.TrimSpace(creature.Name) == "" {
+		return nil, fmt.Errorf("creature name is required")
+	}
+
+	now := time.Now()
+	creature.ID = fmt.Sprintf("creature-%d", now.UnixNano())
+	creature.IsActive = true
+	creature.CreatedAt = now
+	creature.UpdatedAt = now
+
+	if err := a.storage.SaveCreature(creature); err != nil {
+		return nil, err
+	}
+	return creature, nil
+}
+
+// ParseCreatureStatBlock reads a pasted DCC stat block without saving it
+func (a *App) ParseCreatureStatBlock(text string) (*models.Creature, error) {
+	return rules.ParseStatBlock(text)
+}
+
+// ImportCreatureStatBlock parses a pasted DCC stat block and saves it as a new creature
+func (a *App) ImportCreatureStatBlock(text string) (*models.Creature, error) {
+	creature, err := rules.ParseStatBlock(text)
+	if err != nil {
+		return nil, err
+	}
+	return a.CreateCreature(creature)
+}
+
+func (a *App) GetCreature(id string) (*models.Creature, error) {
+	return a.storage.GetCreature(id)
+}
+
+func (a *App) GetCreatures() ([]*models.Creature, error) {
+	return a.storage.GetCreatures()
+}
+
+func (a *App) GetDeletedCreatures() ([]*models.Creature, error) {
+	return a.storage.GetDeletedCreatures()
+}
+
+func (a *App) SaveCreature(creature *models.Creature) error {
+	creature.UpdatedAt = time.Now()
+	return a.storage.SaveCreature(creature)
+}
+
+func (a *App) DeleteCreature(id string) error {
+	return a.storage.DeleteCreature(id)
+}
+
+func (a *App) RestoreCreature(id string) error {
+	return a.storage.RestoreCreature(id)
+}
+
+// LinkCreatureToWorldNote links a creature to a world note, e.g. the NPC note it describes
+func (a *App) LinkCreatureToWorldNote(creatureId string, noteId string) (*models.Creature, error) {
+	creature, err := a.storage.GetCreature(creatureId)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.storage.GetWorldNote(noteId); err != nil {
+		return nil, err
+	}
+
+	for _, id := range creature.WorldNoteIDs {
+		if id == noteId {
+			return creature, nil
+		}
+	}
+	creature.WorldNoteIDs = append(creature.WorldNoteIDs, noteId)
+	creature.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveCreature(creature); err != nil {
+		return nil, err
+	}
+	return creature, nil
+}
+
+// UnlinkCreatureFromWorldNote removes a link between a creature and a world note
+func (a *App) UnlinkCreatureFromWorldNote(creatureId string, noteId string) (*models.Creature, error) {
+	creature, err := a.storage.GetCreature(creatureId)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, id := range creature.WorldNoteIDs {
+		if id != noteId {
+			ids = append(ids, id)
+		}
+	}
+	creature.WorldNoteIDs = ids
+	creature.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveCreature(creature); err != nil {
+		return nil, err
+	}
+	return creature, nil
+}
+
+// GetWorldNoteCreatures returns the active creatures linked to a world note
+func (a *App) GetWorldNoteCreatures(noteId string) ([]*models.Creature, error) {
+	creatures, err := a.storage.GetCreatures()
+	if err != nil {
+		return nil, err
+	}
+
+	linked := []*models.Creature{}
+	for _, creature := range creatures {
+		for _, id := range creature.WorldNoteIDs {
+			if id == noteId {
+				linked = append(linked, creature)
+				break
+			}
+		}
+	}
+	return linked, nil
+}
+
+// Shared table methods
+
+func (a *App) GetSharedTable(id string) (*models.Table, error) {
+	return a.storage.GetSharedTable(id)
+}
+
+func (a *App) GetSharedTables() ([]*models.Table, error) {
+	return a.storage.GetSharedTables()
+}
+
+func (a *App) GetDeletedSharedTables() ([]*models.Table, error) {
+	return a.storage.GetDeletedSharedTables()
+}
+
+func (a *App) SaveSharedTable(table *models.Table) error {
+	if err := rules.ValidateTableEntries(table.Entries); err != nil {
+		return err
+	}
+	rules.SortTableEntries(table.Entries)
+	return a.storage.SaveSharedTable(table)
+}
+
+func (a *App) DeleteSharedTable(id string) error {
+	return a.storage.DeleteSharedTable(id)
+}
+
+func (a *App) RestoreSharedTable(id string) error {
+	return a.storage.RestoreSharedTable(id)
+}
+
+// ImportTableCSV creates a shared table from CSV rows of "range,result"
+func (a *App) ImportTableCSV(name string, dice string, csvData string) (*models.Table, error) {
+	entries, err := rules.ParseTableCSV(csvData)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &models.Table{
+		ID:       fmt.Sprintf("table-%d", time.Now().UnixNano()),
+		Name:     name,
+		Dice:     dice,
+		Entries:  entries,
+		IsActive: true,
+	}
+
+	if err := a.storage.SaveSharedTable(table); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+// ShareCharacterTable copies one of a character's tables into the shared table library
+func (a *App) ShareCharacterTable(characterId string, tableId string) (*models.Table, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, table := range character.Tables {
+		if table.ID != tableId {
+			continue
+		}
+
+		shared := table
+		shared.ID = fmt.Sprintf("table-%d", time.Now().UnixNano())
+		shared.Entries = append([]models.TableEntry{}, table.Entries...)
+		shared.IsActive = true
+
+		if err := a.SaveSharedTable(&shared); err != nil {
+			return nil, err
+		}
+		return &shared, nil
+	}
+
+	return nil, fmt.Errorf("table '%s' not found", tableId)
+}
+
+// AddSharedTableToCharacter copies a shared table onto a character
+func (a *App) AddSharedTableToCharacter(characterId string, tableId string) (*models.Table, error) {
+	shared, err := a.storage.GetSharedTable(tableId)
+	if err != nil {
+		return nil, err
+	}
+
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	table := *shared
+	table.ID = fmt.Sprintf("table-%d", time.Now().UnixNano())
+	table.Entries = append([]models.TableEntry{}, shared.Entries...)
+	table.IsActive = true
+	character.Tables = append(character.Tables, table)
+
+	if err := a.storage.SaveCharacter(character, fmt.Sprintf("Added shared table: %s", table.Name)); err != nil {
+		return nil, err
+	}
+
+	return &table, nil
+}
+
+// Party management methods
+
+func (a *App) CreateParty(name string, description string, characterIds []string) (string, error) {
+	id := fmt.Sprintf("party-%d", time.Now().Unix())
+
+	party := &models.Party{
+		ID:           id,
+		Name:         name,
+		Description:  description,
+		CharacterIDs: characterIds,
+		IsActive:     true,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+	a.syncPartyMembers(party)
+
+	if err := a.storage.SaveParty(party); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (a *App) GetParty(id string) (*models.Party, error) {
+	return a.storage.GetParty(id)
+}
+
+func (a *App) GetParties() ([]*models.Party, error) {
+	return a.storage.GetParties()
+}
+
+func (a *App) GetDeletedParties() ([]*models.Party, error) {
+	return a.storage.GetDeletedParties()
+}
+
+func (a *App) SaveParty(party *models.Party) error {
+	a.syncPartyMembers(party)
+	party.UpdatedAt = time.Now()
+	return a.storage.SaveParty(party)
+}
+
+func (a *App) DeleteParty(id string) error {
+	return a.storage.DeleteParty(id)
+}
+
+func (a *App) RestoreParty(id string) error {
+	return a.storage.RestoreParty(id)
+}
+
+func (a *App) GetPartyCharacters(partyId string) ([]*models.Character, error) {
+	return a.storage.GetPartyCharacters(partyId)
+}
+
+// GetPartySummary returns a party's aggregate health, armor class, level,
+// wealth, carried weight and best saves
+func (a *App) GetPartySummary(partyId string) (*models.PartySummary, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := a.storage.GetPartyCharacters(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.PartySummary(a.catalog, party, members), nil
+}
+
+// AddPartyMember adds a character to a party's roster
+func (a *App) AddPartyMember(partyId string, characterId string) (*models.Party, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range party.CharacterIDs {
+		if id == characterId {
+			return nil, fmt.Errorf("character %s is already in party %s", characterId, party.Name)
+		}
+	}
+	if _, err := a.storage.GetCharacter(characterId); err != nil {
+		return nil, err
+	}
+
+	party.CharacterIDs = append(party.CharacterIDs, characterId)
+	a.syncPartyMembers(party)
+	party.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveParty(party); err != nil {
+		return nil, err
+	}
+	return party, nil
+}
+
+// RemovePartyMember moves a character to a party's former members. reason is
+// died, retired, left, or free text.
+func (a *App) RemovePartyMember(partyId string, characterId string, reason string) (*models.Party, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+	a.syncPartyMembers(party)
+
+	if err := rules.RemovePartyMember(party, characterId, reason, time.Now()); err != nil {
+		return nil, err
+	}
+	party.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveParty(party); err != nil {
+		return nil, err
+	}
+	return party, nil
+}
+
+// SetMarchingOrder places a party's members in marching order, front first
+func (a *App) SetMarchingOrder(partyId string, characterIds []string) (*models.Party, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+	a.syncPartyMembers(party)
+
+	if err := rules.SetMarchingOrder(party, characterIds); err != nil {
+		return nil, err
+	}
+	party.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveParty(party); err != nil {
+		return nil, err
+	}
+	return party, nil
+}
+
+// SetMemberRole sets a party member's role, e.g. torchbearer or caller
+func (a *App) SetMemberRole(partyId string, characterId string, role string) (*models.Party, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+	a.syncPartyMembers(party)
+
+	if err := rules.SetMemberRole(party, characterId, strings.TrimSpace(role)); err != nil {
+		return nil, err
+	}
+	party.UpdatedAt = time.Now()
+
+	if err := a.storage.SaveParty(party); err != nil {
+		return nil, err
+	}
+	return party, nil
+}
+
+// GetPartyHistory returns a party's history entries
+func (a *App) GetPartyHistory(partyId string) ([]models.HistoryEntry, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+	return party.History, nil
+}
+
+// syncPartyMembers brings a party's member list in step with its character
+// IDs, refreshing member names from storage
+func (a *App) syncPartyMembers(party *models.Party) {
+	names := make(map[string]string)
+	for _, id := range party.CharacterIDs {
+		if character, err := a.storage.GetCharacter(id); err == nil {
+			names[id] = character.Name
+		}
+	}
+	rules.SyncPartyMembers(party, names, time.Now())
+}
+
+// AdvanceTime passes game time for every active member of a party. Each night
+// of rest (8 hours or more) heals hit points, spellburn and attribute damage,
+// restores lost spells and regenerates Luck, timed conditions count down and
+// wear off, and dying characters bleed out. Each affected character gets one history entry.
+func (a *App) AdvanceTime(partyId string, duration models.GameDuration, restQuality string) (*models.TimeAdvanceResult, error) {
+	if duration.Value < 0 {
+		return nil, fmt.Errorf("duration cannot be negative")
+	}
+	if restQuality == "" {
+		restQuality = models.RestNone
+	}
+	if !rules.ValidRestQuality(restQuality) {
+		return nil, fmt.Errorf("unknown rest quality '%s'", restQuality)
+	}
+
+	rounds, err := rules.DurationInRounds(duration)
+	if err != nil {
+		return nil, err
+	}
+	days, _ := rules.DurationInDays(duration)
+
+	characters, err := a.storage.GetPartyCharacters(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &models.TimeAdvanceResult{
+		PartyID:     partyId,
+		Duration:    duration,
+		RestQuality: restQuality,
+		Characters:  []models.RestResult{},
+	}
+
+	for _, character := range characters {
+		if !character.IsActive {
+			continue
+		}
+
+		counting := len(character.Conditions) > 0 || !rules.IsAlive(character)
+		rest := rules.Rest(character, days, restQuality)
+		rest.BledOut = rules.AdvanceLifeState(character, rounds)
+		rest.ConditionsExpired = rules.TickConditions(character, rounds)
+
+		changed := rules.RestChanged(rest)
+		if !changed && !counting {
+			continue
+		}
+
+		// Conditions and bleed-out counters that are still running are saved without a history entry
+		if err := a.storage.SaveCharacter(character, rules.RestNote(duration, restQuality, rest)); err != nil {
+			return nil, err
+		}
+		if changed {
+			result.Characters = append(result.Characters, rest)
+		}
+	}
+
+	return result, nil
+}
+
+// Equipment transfer methods
+
+// TransferEquipment moves some or all of an item from one character to
+// another. Both sheets are saved together, with linked history entries.
+func (a *App) TransferEquipment(fromId string, toId string, itemId string, quantity int) (*models.Equipment, error) {
+	if fromId == toId {
+		return nil, fmt.Errorf("cannot transfer an item to the same character")
+	}
+
+	from, err := a.storage.GetCharacter(fromId)
+	if err != nil {
+		return nil, err
+	}
+	to, err := a.storage.GetCharacter(toId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := rules.TakeEquipment(&from.Equipment, itemId, quantity)
+	if err != nil {
+		return nil, err
+	}
+	rules.GiveEquipment(&to.Equipment, item)
+
+	linkId := fmt.Sprintf("transfer-%d", time.Now().UnixNano())
+	err = a.storage.Transaction([]string{fromId, toId}, nil, func() error {
+		note := fmt.Sprintf("Gave %s to %s", rules.DescribeStack(item), to.Name)
+		if err := a.storage.SaveLinkedCharacter(from, note, &models.HistoryLink{ID: linkId, CharacterID: toId}); err != nil {
+			return err
+		}
+		note = fmt.Sprintf("Received %s from %s", rules.DescribeStack(item), from.Name)
+		return a.storage.SaveLinkedCharacter(to, note, &models.HistoryLink{ID: linkId, CharacterID: fromId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// DepositToStash moves some or all of a member's item into their party's stash
+func (a *App) DepositToStash(partyId string, characterId string, itemId string, quantity int) (*models.Party, error) {
+	party, character, err := a.getPartyMember(partyId, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := rules.TakeEquipment(&character.Equipment, itemId, quantity)
+	if err != nil {
+		return nil, err
+	}
+	rules.GiveEquipment(&party.Stash, item)
+	party.UpdatedAt = time.Now()
+
+	link := &models.HistoryLink{ID: fmt.Sprintf("stash-%d", time.Now().UnixNano()), PartyID: partyId}
+	err = a.storage.Transaction([]string{characterId}, []string{partyId}, func() error {
+		note := fmt.Sprintf("Deposited %s in the %s stash", rules.DescribeStack(item), party.Name)
+		if err := a.storage.SaveLinkedCharacter(character, note, link); err != nil {
+			return err
+		}
+		return a.storage.SavePartyWithNote(party, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return party, nil
+}
+
+// WithdrawFromStash moves some or all of an item from a party's stash to one of its members
+func (a *App) WithdrawFromStash(partyId string, characterId string, itemId string, quantity int) (*models.Character, error) {
+	party, character, err := a.getPartyMember(partyId, characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := rules.TakeEquipment(&party.Stash, itemId, quantity)
+	if err != nil {
+		return nil, err
+	}
+	rules.GiveEquipment(&character.Equipment, item)
+	party.UpdatedAt = time.Now()
+
+	link := &models.HistoryLink{ID: fmt.Sprintf("stash-%d", time.Now().UnixNano()), PartyID: partyId}
+	err = a.storage.Transaction([]string{characterId}, []string{partyId}, func() error {
+		note := fmt.Sprintf("Took %s from the %s stash", rules.DescribeStack(item), party.Name)
+		if err := a.storage.SaveLinkedCharacter(character, note, link); err != nil {
+			return err
+		}
+		return a.storage.SavePartyWithNote(party, note)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return character, nil
+}
+
+// SplitLoot divides coins and items among a party's members and saves every
+// recipient, and the stash if it takes the remainder, together
+func (a *App) SplitLoot(partyId string, loot models.Loot, strategy models.LootStrategy) (*models.LootSplitResult, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := a.storage.GetPartyCharacters(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := rules.PlanLootSplit(partyId, members, loot, strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]*models.Character)
+	for _, member := range members {
+		byId[member.ID] = member
+	}
+
+	notes := make(map[string]string)
+	for _, share := range result.Recipients {
+		character := byId[share.CharacterID]
+		if err := rules.AdjustPurse(&character.Purse, share.Coins); err != nil {
+			return nil, err
+		}
+		for _, item := range share.Items {
+			rules.GiveEquipment(&character.Equipment, item)
+		}
+		notes[character.ID] = fmt.Sprintf("Loot split (%s, %d share(s)): received %s", result.Method, share.Shares, rules.DescribeLoot(share.Coins, share.Items))
+	}
+
+	remainder := result.Remainder
+	hasRemainder := remainder.Coins != (models.Purse{}) || len(remainder.Items) > 0
+	if hasRemainder {
+		if remainder.CharacterID == "" {
+			if err := rules.AdjustPurse(&party.StashPurse, remainder.Coins); err != nil {
+				return nil, err
+			}
+			for _, item := range remainder.Items {
+				rules.GiveEquipment(&party.Stash, item)
+			}
+			party.UpdatedAt = time.Now()
+		} else {
+			character := byId[remainder.CharacterID]
+			if err := rules.AdjustPurse(&character.Purse, remainder.Coins); err != nil {
+				return nil, err
+			}
+			for _, item := range remainder.Items {
+				rules.GiveEquipment(&character.Equipment, item)
+			}
+			description := rules.DescribeLoot(remainder.Coins, remainder.Items)
+			if note, ok := notes[character.ID]; ok {
+				notes[character.ID] = note + ", plus the remainder: " + description
+			} else {
+				notes[character.ID] = "Loot split remainder: received " + description
+			}
+		}
+	}
+
+	var characterIds []string
+	for id := range notes {
+		characterIds = append(characterIds, id)
+	}
+	sort.Strings(characterIds)
+
+	link := &models.HistoryLink{ID: fmt.Sprintf("loot-%d", time.Now().UnixNano()), PartyID: partyId}
+	err = a.storage.Transaction(characterIds, []string{partyId}, func() error {
+		for _, id := range characterIds {
+			if err := a.storage.SaveLinkedCharacter(byId[id], notes[id], link); err != nil {
+				return err
+			}
+		}
+		if hasRemainder && remainder.CharacterID == "" {
+			return a.storage.SavePartyWithNote(party, fmt.Sprintf("Loot split (%s): remainder to the stash", result.Method))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// getPartyMember loads a party and one of its members
+func (a *App) getPartyMember(partyId string, characterId string) (*models.Party, *models.Character, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := false
+	for _, id := range party.CharacterIDs {
+		if id == characterId {
+			member = true
+			break
+		}
+	}
+	if !member {
+		return nil, nil, fmt.Errorf("character %s is not in party %s", characterId, party.Name)
+	}
+
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return party, character, nil
+}
+
+// Encounter methods
+
+// StartEncounter rolls initiative for a party's living members and the given
+// NPCs and saves the encounter in turn order
+func (a *App) StartEncounter(partyId string, monsters []models.EncounterMonster) (*models.Encounter, error) {
+	party, err := a.storage.GetParty(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := a.storage.GetPartyCharacters(partyId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	encounter := &models.Encounter{
+		ID:         fmt.Sprintf("encounter-%d", now.UnixNano()),
+		Name:       fmt.Sprintf("%s, %s", party.Name, now.Format("Jan 2 15:04")),
+		PartyID:    partyId,
+		Round:      1,
+		Combatants: []models.Combatant{},
+		Log:        []models.EncounterEvent{},
+		IsActive:   true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	for _, character := range characters {
+		if !character.IsActive || rules.LifeState(character) == models.LifeStateDead {
+			continue
+		}
+
+		combatant, roll := rules.PlayerCombatant(a.roller, a.catalog, character)
+		if err := a.logRoll(character.ID, models.RollReasonInitiative, "Initiative", roll); err != nil {
+			return nil, err
+		}
+		encounter.Combatants = append(encounter.Combatants, combatant)
+	}
+
+	npcs := 0
+	for _, monster := range monsters {
+		if monster.CreatureID != "" {
+			creature, err := a.storage.GetCreature(monster.CreatureID)
+			if err != nil {
+				return nil, err
+			}
+			if !creature.IsActive {
+				return nil, fmt.Errorf("creature '%s' has been deleted", creature.Name)
+			}
+			monster = rules.CreatureMonster(monster, creature)
+		}
+
+		combatants, err := rules.MonsterCombatants(a.roller, monster, npcs)
+		if err != nil {
+			return nil, err
+		}
+		npcs += len(combatants)
+		encounter.Combatants = append(encounter.Combatants, combatants...)
+	}
+
+	if len(encounter.Combatants) == 0 {
+		return nil, fmt.Errorf("no one in %s can fight", party.Name)
+	}
+
+	rules.SortInitiative(encounter.Combatants)
+	for _, combatant := range encounter.Combatants {
+		rules.LogEncounterEvent(encounter, fmt.Sprintf("%s rolls %d for initiative", combatant.Name, combatant.Initiative))
+	}
+	rules.LogEncounterEvent(encounter, "Round 1 begins")
+
+	if err := a.storage.SaveEncounter(encounter); err != nil {
+		return nil, err
+	}
+	return encounter, nil
+}
+
+func (a *App) GetEncounter(id string) (*models.Encounter, error) {
+	return a.storage.GetEncounter(id)
+}
+
+func (a *App) GetEncounters() ([]*models.Encounter, error) {
+	return a.storage.GetEncounters()
+}
+
+func (a *App) GetDeletedEncounters() ([]*models.Encounter, error) {
+	return a.storage.GetDeletedEncounters()
+}
+
+func (a *App) DeleteEncounter(id string) error {
+	return a.storage.DeleteEncounter(id)
+}
+
+func (a *App) RestoreEncounter(id string) error {
+	return a.storage.RestoreEncounter(id)
+}
+
+// NextTurn passes the turn to the next combatant. When a new round begins,
+// every PC's conditions and bleed-out counter advance by a round.
+func (a *App) NextTurn(encounterId string) (*models.Encounter, error) {
+	encounter, err := a.storage.GetEncounter(encounterId)
+	if err != nil {
+		return nil, err
+	}
+
+	newRound, err := rules.NextTurn(encounter)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new round bleeds out the dying and counts down PC conditions. The PCs
+	// and the encounter are saved together.
+	var characters []*models.Character
+	var ids []string
+	if newRound {
+		for i := range encounter.Combatants {
+			combatant := &encounter.Combatants[i]
+			if !combatant.IsPlayer {
+				continue
+			}
+
+			character, err := a.storage.GetCharacter(combatant.CharacterID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load %s: %v", combatant.Name, err)
+			}
+			characters = append(characters, character)
+			ids = append(ids, character.ID)
+
+			bledOut := rules.AdvanceLifeState(character, 1)
+			expired := rules.TickConditions(character, 1)
+			if bledOut {
+				rules.LogEncounterEvent(encounter, fmt.Sprintf("%s bleeds out", character.Name))
+			}
+			for _, name := range expired {
+				rules.LogEncounterEvent(encounter, fmt.Sprintf("%s is no longer %s", character.Name, name))
+			}
+			rules.SyncCombatant(combatant, character)
+		}
+	}
+
+	encounter.UpdatedAt = time.Now()
+	link := a.encounterLink(encounter)
+	note := fmt.Sprintf("%s, round %d", encounter.Name, encounter.Round)
+	err = a.storage.Transaction(ids, nil, func() error {
+		for _, character := range characters {
+			if err := a.storage.SaveLinkedCharacter(character, note, link); err != nil {
+				return err
+			}
+		}
+		return a.storage.SaveEncounter(encounter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return encounter, nil
+}
+
+// DamageCombatant takes hit points from a combatant. A PC's damage is saved to
+// their character, and at 0 HP they start dying.
+func (a *App) DamageCombatant(encounterId string, combatantId string, amount int, source string) (*models.Encounter, error) {
+	if amount < 1 {
+		return nil, fmt.Errorf("damage must be at least 1")
+	}
+
+	return a.updateCombatant(encounterId, combatantId, func(combatant *models.Combatant, character *models.Character) (string, error) {
+		message := fmt.Sprintf("%s takes %d damage", combatant.Name, amount)
+		if source != "" {
+			message += " from " + source
+		}
+
+		if character == nil {
+			rules.DamageMonster(combatant, amount)
+			if combatant.Defeated {
+				message += " and falls"
+			}
+			return message, nil
+		}
+
+		if err := rules.DamageCharacter(character, amount, source); err != nil {
+			return "", err
+		}
+		switch rules.LifeState(character) {
+		case models.LifeStateDying:
+			message += fmt.Sprintf(" and is dying (%d rounds to live)", character.Life.RoundsRemaining)
+		case models.LifeStateDead:
+			message += " and dies"
+		}
+		return message, nil
+	})
+}
+
+// HealCombatant restores a combatant's hit points. Healing a dying PC stabilizes them.
+func (a *App) HealCombatant(encounterId string, combatantId string, amount int, source string) (*models.Encounter, error) {
+	if amount < 1 {
+		return nil, fmt.Errorf("healing must be at least 1")
+	}
+
+	return a.updateCombatant(encounterId, combatantId, func(combatant *models.Combatant, character *models.Character) (string, error) {
+		message := fmt.Sprintf("%s heals %d", combatant.Name, amount)
+		if source != "" {
+			message += " from " + source
+		}
+
+		if character == nil {
+			rules.HealMonster(combatant, amount)
+			return message, nil
+		}
+
+		dying := rules.LifeState(character) == models.LifeStateDying
+		if err := rules.HealCharacter(character, amount); err != nil {
+			return "", err
+		}
+		if dying {
+			message += " and is stabilized"
+		}
+		return message, nil
+	})
+}
+
+// EndEncounter closes an encounter. It stays in the list until deleted.
+func (a *App) EndEncounter(encounterId string) (*models.Encounter, error) {
+	encounter, err := a.storage.GetEncounter(encounterId)
+	if err != nil {
+		return nil, err
+	}
+	if encounter.Ended {
+		return encounter, nil
+	}
+
+	encounter.Ended = true
+	encounter.EndedAt = time.Now()
+	encounter.UpdatedAt = encounter.EndedAt
+	rules.LogEncounterEvent(encounter, fmt.Sprintf("Encounter ends after %d rounds", encounter.Round))
+
+	if err := a.storage.SaveEncounter(encounter); err != nil {
+		return nil, err
+	}
+	return encounter, nil
+}
+
+// updateCombatant loads an encounter and applies change to a combatant. PCs
+// are loaded, changed and saved with the returned message as the history
+// note; character is nil for NPCs.
+func (a *App) updateCombatant(encounterId string, combatantId string, change func(*models.Combatant, *models.Character) (string, error)) (*models.Encounter, error) {
+	encounter, err := a.storage.GetEncounter(encounterId)
+	if err != nil {
+		return nil, err
+	}
+	if encounter.Ended {
+		return nil, fmt.Errorf("%s has ended", encounter.Name)
+	}
+
+	combatant, err := rules.FindCombatant(encounter, combatantId)
+	if err != nil {
+		return nil, err
+	}
+
+	var character *models.Character
+	if combatant.IsPlayer {
+		character, err = a.storage.GetCharacter(combatant.CharacterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message, err := change(combatant, character)
+	if err != nil {
+		return nil, err
+	}
+
+	if character != nil {
+		note := fmt.Sprintf("%s (%s, round %d)", message, encounter.Name, encounter.Round)
+		if err := a.storage.SaveLinkedCharacter(character, note, a.encounterLink(encounter)); err != nil {
+			return nil, err
+		}
+		rules.SyncCombatant(combatant, character)
+	}
+
+	rules.LogEncounterEvent(encounter, message)
+	encounter.UpdatedAt = time.Now()
+	if err := a.storage.SaveEncounter(encounter); err != nil {
+		return nil, err
+	}
+	return encounter, nil
+}
+
+// encounterLink tags a character's history entry with the encounter it came from
+func (a *App) encounterLink(encounter *models.Encounter) *models.HistoryLink {
+	return &models.HistoryLink{
+		ID:      encounter.ID,
+		PartyID: encounter.PartyID,
+		Session: models.SessionKey(encounter.CreatedAt),
+	}
+}
+
+// Dice methods
+
+// Roll rolls a dice expression such as "1d20+2" or "1d20+1d14" and returns each die result
+func (a *App) Roll(expr string) (*dice.Result, error) {
+	return a.roller.Roll(expr)
+}
+
+// StepDice moves every die in an expression up or down the DCC dice chain
+func (a *App) StepDice(expr string, steps int) (string, error) {
+	return dice.StepExpression(expr, steps)
+}
+
+// RollForCharacter rolls a dice expression for a character and records it in their roll log
+func (a *App) RollForCharacter(characterId string, reason string, label string, expr string) (*dice.Result, error) {
+	if _, err := a.storage.GetCharacter(characterId); err != nil {
+		return nil, err
+	}
+
+	result, err := a.roller.Roll(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, reason, label, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// logRoll records a roll result in a character's roll log
+func (a *App) logRoll(characterId string, reason string, label string, result *dice.Result) error {
+	now := time.Now()
+
+	entry := models.RollLogEntry{
+		ID:          fmt.Sprintf("roll-%d", now.UnixNano()),
+		CharacterID: characterId,
+		Session:     models.SessionKey(now),
+		Reason:      reason,
+		Label:       label,
+		Expression:  result.Expression,
+		Dice:        []models.RollDie{},
+		Modifier:    result.Modifier,
+		Total:       result.Total,
+		Timestamp:   now,
+	}
+
+	for _, die := range result.Dice {
+		value := die.Value
+		if die.Negative {
+			value = -value
+		}
+		entry.Dice = append(entry.Dice, models.RollDie{Sides: die.Sides, Value: value})
+	}
+
+	return a.storage.AppendRollLog(entry)
+}
+
+// RollCrit rolls a character's crit die plus Luck modifier on their crit table
+func (a *App) RollCrit(characterId string) (*models.CritResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, roll, err := rules.RollCrit(a.roller, a.catalog, character)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonCrit, "Crit table "+result.Table, withModifier(roll, result.Modifier)); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RollFumble rolls a character's armor fumble die, adjusted by Luck, on the fumble table
+func (a *App) RollFumble(characterId string) (*models.CritResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, roll, err := rules.RollFumble(a.roller, a.catalog, character)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonFumble, result.Table, withModifier(roll, result.Modifier)); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// RollTable rolls on one of a character's custom tables (or a shared table),
+// applies the modifier and returns the matching entry
+func (a *App) RollTable(characterId string, tableId string, modifier int) (*models.TableRollResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	var table *models.Table
+	for i := range character.Tables {
+		if character.Tables[i].ID == tableId && character.Tables[i].IsActive {
+			table = &character.Tables[i]
+			break
+		}
+	}
+
+	if table == nil {
+		table, err = a.storage.GetSharedTable(tableId)
+		if err != nil {
+			return nil, fmt.Errorf("table '%s' not found", tableId)
+		}
+	}
+
+	result, roll, err := rules.RollTable(a.roller, table, modifier)
+	if err != nil {
+		return nil, err
+	}
+	result.CharacterID = characterId
+
+	if err := a.logRoll(characterId, models.RollReasonTable, table.Name, withModifier(roll, modifier)); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CastSpell rolls a spell check for one of a character's spells, marks the
+// spell lost when appropriate and logs the roll
+func (a *App) CastSpell(characterId string, abilityId string) (*models.SpellCastResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	spell, err := rules.FindSpell(character, abilityId)
+	if err != nil {
+		return nil, err
+	}
+
+	var resultsTable *models.Table
+	if spell.ResultsTableID != "" {
+		for i := range character.Tables {
+			if character.Tables[i].ID == spell.ResultsTableID && character.Tables[i].IsActive {
+				resultsTable = &character.Tables[i]
+				break
+			}
+		}
+		if resultsTable == nil {
+			resultsTable, _ = a.storage.GetSharedTable(spell.ResultsTableID)
+		}
+	}
+
+	disapproval := character.ClassState.Disapproval
+	result, roll, err := rules.CastSpell(a.roller, a.catalog, character, spell, resultsTable)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonSpellCheck, spell.Name, roll); err != nil {
+		return nil, err
+	}
+
+	if result.Lost || character.ClassState.Disapproval != disapproval {
+		note := fmt.Sprintf("Cast %s (spell check %d): %s", spell.Name, result.Total, result.Outcome)
+		if err := a.storage.SaveCharacter(character, note); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// Class feature methods
+
+// GetClassFeatures returns the deed die, thief skills, disapproval range and
+// other class mechanics that apply to a character
+func (a *App) GetClassFeatures(characterId string) (*models.ClassFeatures, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules.ClassFeatures(a.catalog, character), nil
+}
+
+// RollMightyDeed rolls a warrior's or dwarf's attack with their deed die
+func (a *App) RollMightyDeed(characterId string, missile bool) (*models.MightyDeedResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, roll, err := rules.RollMightyDeed(a.roller, a.catalog, character, missile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonAttack, "Mighty Deed", roll); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ThiefSkillCheck rolls a thief skill against a DC
+func (a *App) ThiefSkillCheck(characterId string, skill string, dc int) (*models.SkillCheckResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, roll, err := rules.ThiefSkillCheck(a.roller, a.catalog, character, skill, dc)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonSkill, result.Skill, roll); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// LayOnHands has a cleric heal a target, who may be the cleric themself
+func (a *App) LayOnHands(clericId string, targetId string) (*models.ClericCheckResult, error) {
+	cleric, err := a.storage.GetCharacter(clericId)
+	if err != nil {
+		return nil, err
+	}
+
+	target := cleric
+	if targetId != clericId {
+		target, err = a.storage.GetCharacter(targetId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, roll, err := rules.LayOnHands(a.roller, a.catalog, cleric, target)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(clericId, models.RollReasonSpellCheck, "Lay on hands", roll); err != nil {
+		return nil, err
+	}
+
+	note := fmt.Sprintf("Lay on hands on %s (check %d): %s", target.Name, result.Total, result.Outcome)
+	if target == cleric || result.Healed == 0 {
+		if err := a.storage.SaveCharacter(cleric, note); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	linkId := fmt.Sprintf("lay-%d", time.Now().UnixNano())
+	err = a.storage.Transaction([]string{clericId, targetId}, nil, func() error {
+		if err := a.storage.SaveLinkedCharacter(cleric, note, &models.HistoryLink{ID: linkId, CharacterID: targetId}); err != nil {
+			return err
+		}
+		note := fmt.Sprintf("Healed by %s's lay on hands", cleric.Name)
+		return a.storage.SaveLinkedCharacter(target, note, &models.HistoryLink{ID: linkId, CharacterID: clericId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// TurnUnholy rolls a cleric's turn unholy check
+func (a *App) TurnUnholy(characterId string) (*models.ClericCheckResult, error) {
+	character, err := a.storage.GetCharacter(characterId)
+	if err != nil {
+		return nil, err
+	}
+
+	result, roll, err := rules.TurnUnholy(a.roller, character)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.logRoll(characterId, models.RollReasonSpellCheck, "Turn unholy", roll); err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		note := fmt.Sprintf("Turn unholy (check %d): %s", result.Total, result.Outcome)
+		if err := a.storage.SaveCharacter(character, note); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// withModifier returns a copy of a roll result with an extra flat modifier applied
+func withModifier(roll *dice.Result, modifier int) *dice.Result {
+	adjusted := *roll
+	adjusted.Modifier += modifier
+	adjusted.Total += modifier
+	return &adjusted
+}
+
+// Roll log methods
+
+func (a *App) GetRollLog(characterId string, filter models.RollLogFilter) ([]models.RollLogEntry, error) {
+	return a.storage.GetRollLog(characterId, filter)
+}
+
+// GetRollStats summarizes a character's logged rolls, including their natural 20 rate
+func (a *App) GetRollStats(characterId string) (*models.RollStats, error) {
+	entries, err := a.storage.GetRollLog(characterId, models.RollLogFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.RollStats{
+		CharacterID: characterId,
+		TotalRolls:  len(entries),
+	}
+
+	for _, entry := range entries {
+		if len(entry.Dice) == 0 || entry.Dice[0].Sides != 20 {
+			continue
+		}
+
+		stats.D20Rolls++
+		switch entry.Dice[0].Value {
+		case 20:
+			stats.NaturalTwenties++
+		case 1:
+			stats.NaturalOnes++
+		}
+	}
+
+	if stats.D20Rolls > 0 {
+		stats.NaturalTwentyRate = float64(stats.NaturalTwenties) / float64(stats.D20Rolls)
+		stats.NaturalOneRate = float64(stats.NaturalOnes) / float64(stats.D20Rolls)
+	}
+
+	return stats, nil
+}
+
+// GetSessionRecap returns the rolls and history entries for a session.
+// An empty session means today's session.
+func (a *App) GetSessionRecap(session string) (*models.SessionRecap, error) {
+	if session == "" {
+		session = models.SessionKey(time.Now())
+	}
+
+	rolls, err := a.storage.GetSessionRolls(session)
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := a.storage.GetCharacters()
+	if err != nil {
+		return nil, err
+	}
+
+	recap := &models.SessionRecap{
+		Session: session,
+		Rolls:   rolls,
+		History: []models.SessionHistoryEntry{},
+	}
+
+	for _, character := range characters {
+		for _, entry := range character.History {
+			if models.SessionKey(entry.Timestamp) != session {
+				continue
+			}
+			recap.History = append(recap.History, models.SessionHistoryEntry{
+				CharacterID:   character.ID,
+				CharacterName: character.Name,
+				Entry:         entry,
+			})
+		}
+	}
+
+	sort.Slice(recap.History, func(i, j int) bool {
+		return recap.History[i].Entry.Timestamp.Before(recap.History[j].Entry.Timestamp)
+	})
+
+	return recap, nil
+}
+
+// Catalog methods
+
+// GetCatalog returns the reference catalog with any house rules applied
+func (a *App) GetCatalog() *catalog.Catalog {
+	return a.catalog
+}
+
+// ReloadCatalog re-reads the house rules catalog override file
+func (a *App) ReloadCatalog() error {
+	c, err := catalog.Load(a.storage.CatalogOverridePath())
+	a.catalogError = err
+	if err != nil {
+		return err
+	}
+
+	a.catalog = c
+	return nil
+}
+
+// GetCatalogError returns why the catalog override failed to load, or an
+// empty string if it loaded (or there is none)
+func (a *App) GetCatalogError() string {
+	if a.catalogError == nil {
+		return ""
+	}
+	return a.catalogError.Error()
+}
+
+// GetCatalogOverridePath returns where the house rules catalog file is read from
+func (a *App) GetCatalogOverridePath() string {
+	return a.storage.CatalogOverridePath()
+}
+
+func (a *App) SearchCatalog(query string) *catalog.SearchResults {
+	return a.catalog.Search(query)
+}
+
+func (a *App) GetOccupation(name string) (*catalog.Occupation, error) {
+	return a.catalog.GetOccupation(name)
+}
+
+func (a *App) GetWeapon(name string) (*catalog.Weapon, error) {
+	return a.catalog.GetWeapon(name)
+}
+
+func (a *App) GetArmor(name string) (*catalog.Armor, error) {
+	return a.catalog.GetArmor(name)
+}
+
+func (a *App) GetGear(name string) (*catalog.Gear, error) {
+	return a.catalog.GetGear(name)
+}
+
+func (a *App) GetCritTable(name string) (*catalog.ResultTable, error) {
+	return a.catalog.GetCritTable(name)
+}
+
+func (a *App) GetFumbleTable() (*catalog.ResultTable, error) {
+	return a.catalog.GetFumbleTable()
+}
+
+func (a *App) GetClassProgression(name string) (*catalog.ClassProgression, error) {
+	return a.catalog.GetClass(name)
 }
 
 // Image management methods
@@ -0,0 +1,22 @@
+package main
+
+import "testing"
+
+func TestSplitDelta(t *testing.T) {
+	tests := []struct {
+		amount int
+		gained int
+		spent  int
+	}{
+		{0, 0, 0},
+		{5, 5, 0},
+		{-3, 0, 3},
+	}
+
+	for _, tt := range tests {
+		gained, spent := splitDelta(tt.amount)
+		if gained != tt.gained || spent != tt.spent {
+			t.Errorf("splitDelta(%d) = %d, %d, want %d, %d", tt.amount, gained, spent, tt.gained, tt.spent)
+		}
+	}
+}
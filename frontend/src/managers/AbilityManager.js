@@ -118,8 +118,12 @@ export class AbilityManager {
      */
     collectAbilities() {
         const items = [];
+        const existingAbilities = this.characterManager.currentCharacter?.abilities || [];
         document.querySelectorAll('.ability-item').forEach(div => {
+            // Keep spell state, which is managed by the backend rather than this form
+            const existing = existingAbilities.find(a => a.id === div.dataset.id) || {};
             items.push({
+                ...existing,
                 id: div.dataset.id,
                 name: div.querySelector('.ability-name').value,
                 description: div.querySelector('.ability-description').value,
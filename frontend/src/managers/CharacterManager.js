@@ -241,6 +241,8 @@ export class CharacterManager {
             const tables = window.tableManager ? window.tableManager.collectTables() : [];
 
             const character = {
+                // Keep fields managed by the backend that have no form input
+                ...this.currentCharacter,
                 id: id,
                 name: document.getElementById('name').value,
                 level: parseInt(document.getElementById('level').value) || 0,
@@ -95,6 +95,14 @@ export class EquipmentManager {
                     <label>Willpower:</label>
                     <input type="number" class="eq-willpower" value="${item.willpowerSave || 0}" ${!item.isActive ? 'disabled' : ''}>
                 </div>
+                <div class="form-group-inline">
+                    <label>Check Penalty:</label>
+                    <input type="number" class="eq-check-penalty" value="${item.checkPenalty || item.checkPenaltyOverride ? item.checkPenalty || 0 : ''}" placeholder="From catalog" ${!item.isActive ? 'disabled' : ''}>
+                </div>
+                <div class="form-group-inline">
+                    <label>Fumble Die:</label>
+                    <input type="text" class="eq-fumble-die" value="${item.fumbleDie || ''}" placeholder="e.g. 1d8" ${!item.isActive ? 'disabled' : ''}>
+                </div>
             </div>
         ` : '';
         
@@ -233,10 +241,18 @@ export class EquipmentManager {
                 const fortitude = div.querySelector('.eq-fortitude');
                 const reflex = div.querySelector('.eq-reflex');
                 const willpower = div.querySelector('.eq-willpower');
+                const checkPenalty = div.querySelector('.eq-check-penalty');
+                const fumbleDie = div.querySelector('.eq-fumble-die');
                 if (acBonus) item.acBonus = parseInt(acBonus.value) || 0;
                 if (fortitude) item.fortitudeSave = parseInt(fortitude.value) || 0;
                 if (reflex) item.reflexSave = parseInt(reflex.value) || 0;
                 if (willpower) item.willpowerSave = parseInt(willpower.value) || 0;
+                if (checkPenalty) {
+                    // A blank penalty uses the catalog value; any number, even 0, overrides it
+                    item.checkPenaltyOverride = checkPenalty.value.trim() !== '';
+                    item.checkPenalty = parseInt(checkPenalty.value) || 0;
+                }
+                if (fumbleDie) item.fumbleDie = fumbleDie.value;
             }
             
             items.push(item);
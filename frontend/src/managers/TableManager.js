@@ -97,8 +97,12 @@ export class TableManager {
      */
     collectTables() {
         const items = [];
+        const existingTables = this.characterManager.currentCharacter?.tables || [];
         document.querySelectorAll('.table-item').forEach(div => {
+            // Keep entries, which are edited through the backend rather than this form
+            const existing = existingTables.find(t => t.id === div.dataset.id) || {};
             items.push({
+                ...existing,
                 id: div.dataset.id,
                 name: div.querySelector('.table-name').value,
                 number: div.querySelector('.table-number').value,
@@ -73,10 +73,18 @@ export function collectEquipmentFromForm() {
             const fortitude = div.querySelector('.eq-fortitude');
             const reflex = div.querySelector('.eq-reflex');
             const willpower = div.querySelector('.eq-willpower');
+            const checkPenalty = div.querySelector('.eq-check-penalty');
+            const fumbleDie = div.querySelector('.eq-fumble-die');
             if (acBonus) item.acBonus = parseInt(acBonus.value) || 0;
             if (fortitude) item.fortitudeSave = parseInt(fortitude.value) || 0;
             if (reflex) item.reflexSave = parseInt(reflex.value) || 0;
             if (willpower) item.willpowerSave = parseInt(willpower.value) || 0;
+            if (checkPenalty) {
+                // A blank penalty uses the catalog value; any number, even 0, overrides it
+                item.checkPenaltyOverride = checkPenalty.value.trim() !== '';
+                item.checkPenalty = parseInt(checkPenalty.value) || 0;
+            }
+            if (fumbleDie) item.fumbleDie = fumbleDie.value;
         }
 
         items.push(item);
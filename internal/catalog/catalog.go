@@ -0,0 +1,630 @@
+package catalog
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed data/catalog.json
+var dataFS embed.FS
+
+// Occupation represents an entry on the 0-level occupation table
+type Occupation struct {
+	MinRoll       int    `json:"minRoll"`
+	MaxRoll       int    `json:"maxRoll"`
+	Name          string `json:"name"`
+	Race          string `json:"race"` // human, dwarf, elf, halfling
+	TrainedWeapon string `json:"trainedWeapon"`
+	WeaponDamage  string `json:"weaponDamage"`
+	TradeGoods    string `json:"tradeGoods"`
+}
+
+// LuckSign represents a birth augur and the rolls it modifies
+type LuckSign struct {
+	Roll    int    `json:"roll"`
+	Name    string `json:"name"`
+	Affects string `json:"affects"`
+	Key     string `json:"key"` // Stable identifier used by the rules engine
+}
+
+// Weapon represents an entry on the weapon list. Costs are in gold pieces.
+type Weapon struct {
+	Name      string  `json:"name"`
+	Damage    string  `json:"damage"`
+	Cost      float64 `json:"cost"`
+	Weight    float64 `json:"weight"`
+	Range     string  `json:"range"` // Short/medium/long in feet, empty for melee weapons
+	TwoHanded bool    `json:"twoHanded"`
+	Notes     string  `json:"notes"`
+}
+
+// Armor represents an entry on the armor list. Costs are in gold pieces.
+type Armor struct {
+	Name         string  `json:"name"`
+	ACBonus      int     `json:"acBonus"`
+	CheckPenalty int     `json:"checkPenalty"`
+	SpeedPenalty int     `json:"speedPenalty"` // In feet
+	FumbleDie    string  `json:"fumbleDie"`
+	Cost         float64 `json:"cost"`
+	Weight       float64 `json:"weight"`
+}
+
+// Gear represents an entry on the equipment list. Costs are in gold pieces.
+type Gear struct {
+	Name   string  `json:"name"`
+	Cost   float64 `json:"cost"`
+	Weight float64 `json:"weight"`
+}
+
+// ClassProgression represents a class and its level advancement table
+type ClassProgression struct {
+	Name   string             `json:"name"`
+	HitDie string             `json:"hitDie"`
+	Levels []LevelProgression `json:"levels"`
+}
+
+// LevelProgression represents one row of a class advancement table
+type LevelProgression struct {
+	Level       int    `json:"level"`
+	AttackBonus int    `json:"attackBonus"`
+	DeedDie     string `json:"deedDie"` // Warriors and dwarves roll a deed die instead of a fixed bonus
+	CritDie     string `json:"critDie"`
+	CritTable   string `json:"critTable"`
+	ThreatRange int    `json:"threatRange"` // Lowest natural roll that scores a critical hit
+	ActionDice  string `json:"actionDice"`
+	Reflex      int    `json:"reflex"`
+	Fortitude   int    `json:"fortitude"`
+	Willpower   int    `json:"willpower"`
+}
+
+// Level returns the advancement row for a level
+func (cp *ClassProgression) Level(level int) (*LevelProgression, error) {
+	for i := range cp.Levels {
+		if cp.Levels[i].Level == level {
+			return &cp.Levels[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s has no level %d", cp.Name, level)
+}
+
+// MaxLevel returns the highest level in the advancement table
+func (cp *ClassProgression) MaxLevel() int {
+	max := 0
+	for _, row := range cp.Levels {
+		if row.Level > max {
+			max = row.Level
+		}
+	}
+	return max
+}
+
+// ThiefSkill represents a row of the thief skill table. Bonuses are indexed
+// from level 1 for each alignment.
+type ThiefSkill struct {
+	Name    string `json:"name"`
+	Ability string `json:"ability"` // Attribute whose modifier applies, empty for none
+	Lawful  []int  `json:"lawful"`
+	Neutral []int  `json:"neutral"`
+	Chaotic []int  `json:"chaotic"`
+}
+
+// Bonus returns the skill bonus for a thief of a level and alignment (0=Neutral, 1=Lawful, 2=Chaotic)
+func (ts *ThiefSkill) Bonus(level int, alignment int) int {
+	column := ts.Neutral
+	switch alignment {
+	case 1:
+		column = ts.Lawful
+	case 2:
+		column = ts.Chaotic
+	}
+	return byLevel(column, level)
+}
+
+// ThiefScrollDice is the die thieves roll to cast spells from scrolls, by alignment and level
+type ThiefScrollDice struct {
+	Lawful  []string `json:"lawful"`
+	Neutral []string `json:"neutral"`
+	Chaotic []string `json:"chaotic"`
+}
+
+// Die returns the scroll die for a thief of a level and alignment
+func (sd *ThiefScrollDice) Die(level int, alignment int) string {
+	column := sd.Neutral
+	switch alignment {
+	case 1:
+		column = sd.Lawful
+	case 2:
+		column = sd.Chaotic
+	}
+	if len(column) == 0 {
+		return ""
+	}
+	return column[clampLevel(level, len(column))-1]
+}
+
+// byLevel returns the value for a level from a column indexed from level 1,
+// clamping levels outside the column
+func byLevel(column []int, level int) int {
+	if len(column) == 0 {
+		return 0
+	}
+	return column[clampLevel(level, len(column))-1]
+}
+
+func clampLevel(level int, max int) int {
+	if level < 1 {
+		return 1
+	}
+	if level > max {
+		return max
+	}
+	return level
+}
+
+// EncumbranceTier represents a band of carried weight and its penalties.
+// Penalties are negative, like armor's.
+type EncumbranceTier struct {
+	Name                 string  `json:"name"`
+	MaxWeightPerStrength float64 `json:"maxWeightPerStrength"` // Carrying limit is this times Strength; 0 for no limit
+	SpeedPenalty         int     `json:"speedPenalty"`         // In feet
+	CheckPenalty         int     `json:"checkPenalty"`
+}
+
+// ResultTable represents a table of ranged results, such as a crit or fumble table
+type ResultTable struct {
+	Name    string       `json:"name"`
+	Entries []TableEntry `json:"entries"` // Ordered from lowest to highest roll
+}
+
+// TableEntry represents a ranged result on a ResultTable
+type TableEntry struct {
+	Min    int    `json:"min"`
+	Max    int    `json:"max"`
+	Result string `json:"result"`
+}
+
+// Lookup returns the entry for a roll. Rolls outside the table are clamped to
+// the first or last entry, so the lowest and highest entries read as
+// "or less" and "or more".
+func (t *ResultTable) Lookup(roll int) (*TableEntry, error) {
+	if len(t.Entries) == 0 {
+		return nil, fmt.Errorf("table '%s' has no entries", t.Name)
+	}
+
+	if roll < t.Entries[0].Min {
+		return &t.Entries[0], nil
+	}
+
+	last := &t.Entries[len(t.Entries)-1]
+	if roll > last.Max {
+		return last, nil
+	}
+
+	for i := range t.Entries {
+		if roll >= t.Entries[i].Min && roll <= t.Entries[i].Max {
+			return &t.Entries[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("table '%s' has no entry for %d", t.Name, roll)
+}
+
+// Catalog holds the reference data tables
+type Catalog struct {
+	Version         string             `json:"version"`
+	OverrideVersion string             `json:"overrideVersion,omitempty"` // Version of the applied house rules file
+	Occupations     []Occupation       `json:"occupations"`
+	LuckSigns       []LuckSign         `json:"luckSigns"`
+	Weapons         []Weapon           `json:"weapons"`
+	Armor           []Armor            `json:"armor"`
+	Gear            []Gear             `json:"gear"`
+	Classes         []ClassProgression `json:"classes"`
+	CritTables      []ResultTable      `json:"critTables"`
+	FumbleTable     *ResultTable       `json:"fumbleTable,omitempty"`
+	ThiefSkills     []ThiefSkill       `json:"thiefSkills"`
+	ThiefScrollDice *ThiefScrollDice   `json:"thiefScrollDice,omitempty"`
+	Encumbrance     []EncumbranceTier  `json:"encumbrance"` // Ordered from lightest to heaviest
+}
+
+// SearchResults holds the catalog entries matching a search
+type SearchResults struct {
+	Occupations []Occupation `json:"occupations"`
+	LuckSigns   []LuckSign   `json:"luckSigns"`
+	Weapons     []Weapon     `json:"weapons"`
+	Armor       []Armor      `json:"armor"`
+	Gear        []Gear       `json:"gear"`
+}
+
+var (
+	defaultCatalog *Catalog
+	defaultOnce    sync.Once
+	defaultErr     error
+)
+
+// Default returns the embedded catalog
+func Default() (*Catalog, error) {
+	defaultOnce.Do(func() {
+		data, err := dataFS.ReadFile("data/catalog.json")
+		if err != nil {
+			defaultErr = err
+			return
+		}
+
+		var c Catalog
+		if err := json.Unmarshal(data, &c); err != nil {
+			defaultErr = fmt.Errorf("embedded catalog is invalid: %v", err)
+			return
+		}
+		defaultCatalog = &c
+	})
+
+	return defaultCatalog, defaultErr
+}
+
+// Load returns the embedded catalog with the house rules file at overridePath
+// applied on top. A missing override file is not an error; an override whose
+// occupations don't cover every d100 roll is.
+func Load(overridePath string) (*Catalog, error) {
+	base, err := Default()
+	if err != nil {
+		return nil, err
+	}
+
+	c := base.clone()
+
+	data, err := os.ReadFile(overridePath)
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var override Catalog
+	if err := json.Unmarshal(data, &override); err != nil {
+		return nil, fmt.Errorf("catalog override %s is invalid: %v", overridePath, err)
+	}
+	if len(override.Occupations) > 0 {
+		if err := validateOccupations(override.Occupations); err != nil {
+			return nil, fmt.Errorf("catalog override %s is invalid: %v", overridePath, err)
+		}
+	}
+
+	c.apply(&override)
+	return c, nil
+}
+
+// validateOccupations checks that occupation roll ranges cover 1-100 with no
+// gaps or overlaps, so every d100 roll finds an occupation
+func validateOccupations(occupations []Occupation) error {
+	sorted := append([]Occupation{}, occupations...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].MinRoll < sorted[j].MinRoll
+	})
+
+	next := 1
+	for _, occupation := range sorted {
+		if occupation.MinRoll > occupation.MaxRoll {
+			return fmt.Errorf("occupation '%s' has an invalid range %d-%d", occupation.Name, occupation.MinRoll, occupation.MaxRoll)
+		}
+		if occupation.MinRoll < 1 {
+			return fmt.Errorf("occupation '%s' starts below 1", occupation.Name)
+		}
+		if occupation.MinRoll < next {
+			return fmt.Errorf("occupation '%s' overlaps another occupation at %d", occupation.Name, occupation.MinRoll)
+		}
+		if occupation.MinRoll > next {
+			return fmt.Errorf("no occupation for rolls %d-%d", next, occupation.MinRoll-1)
+		}
+		next = occupation.MaxRoll + 1
+	}
+
+	if next != 101 {
+		if next > 101 {
+			return fmt.Errorf("occupations run past 100")
+		}
+		return fmt.Errorf("no occupation for rolls %d-100", next)
+	}
+	return nil
+}
+
+func (c *Catalog) clone() *Catalog {
+	return &Catalog{
+		Version:         c.Version,
+		Occupations:     append([]Occupation{}, c.Occupations...),
+		LuckSigns:       append([]LuckSign{}, c.LuckSigns...),
+		Weapons:         append([]Weapon{}, c.Weapons...),
+		Armor:           append([]Armor{}, c.Armor...),
+		Gear:            append([]Gear{}, c.Gear...),
+		Classes:         append([]ClassProgression{}, c.Classes...),
+		CritTables:      append([]ResultTable{}, c.CritTables...),
+		FumbleTable:     c.FumbleTable,
+		ThiefSkills:     append([]ThiefSkill{}, c.ThiefSkills...),
+		ThiefScrollDice: c.ThiefScrollDice,
+		Encumbrance:     append([]EncumbranceTier{}, c.Encumbrance...),
+	}
+}
+
+// apply merges an override into the catalog. The occupation table is replaced
+// as a whole since its roll ranges must cover 1-100; luck signs are replaced
+// by roll; weapons, armor, gear, classes, crit tables and thief skills are
+// replaced by name or appended; the fumble table, thief scroll dice and
+// encumbrance tiers are replaced as a whole.
+func (c *Catalog) apply(override *Catalog) {
+	c.OverrideVersion = override.Version
+	if c.OverrideVersion == "" {
+		c.OverrideVersion = "custom"
+	}
+
+	if len(override.Occupations) > 0 {
+		c.Occupations = append([]Occupation{}, override.Occupations...)
+	}
+
+	for _, sign := range override.LuckSigns {
+		replaced := false
+		for i := range c.LuckSigns {
+			if c.LuckSigns[i].Roll == sign.Roll {
+				c.LuckSigns[i] = sign
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			c.LuckSigns = append(c.LuckSigns, sign)
+		}
+	}
+
+	for _, weapon := range override.Weapons {
+		if i := c.weaponIndex(weapon.Name); i != -1 {
+			c.Weapons[i] = weapon
+		} else {
+			c.Weapons = append(c.Weapons, weapon)
+		}
+	}
+
+	for _, armor := range override.Armor {
+		if i := c.armorIndex(armor.Name); i != -1 {
+			c.Armor[i] = armor
+		} else {
+			c.Armor = append(c.Armor, armor)
+		}
+	}
+
+	for _, gear := range override.Gear {
+		if i := c.gearIndex(gear.Name); i != -1 {
+			c.Gear[i] = gear
+		} else {
+			c.Gear = append(c.Gear, gear)
+		}
+	}
+
+	for _, class := range override.Classes {
+		if i := c.classIndex(class.Name); i != -1 {
+			c.Classes[i] = class
+		} else {
+			c.Classes = append(c.Classes, class)
+		}
+	}
+
+	for _, table := range override.CritTables {
+		if i := c.critTableIndex(table.Name); i != -1 {
+			c.CritTables[i] = table
+		} else {
+			c.CritTables = append(c.CritTables, table)
+		}
+	}
+
+	if override.FumbleTable != nil {
+		c.FumbleTable = override.FumbleTable
+	}
+
+	for _, skill := range override.ThiefSkills {
+		if i := c.thiefSkillIndex(skill.Name); i != -1 {
+			c.ThiefSkills[i] = skill
+		} else {
+			c.ThiefSkills = append(c.ThiefSkills, skill)
+		}
+	}
+
+	if override.ThiefScrollDice != nil {
+		c.ThiefScrollDice = override.ThiefScrollDice
+	}
+
+	if len(override.Encumbrance) > 0 {
+		c.Encumbrance = append([]EncumbranceTier{}, override.Encumbrance...)
+	}
+}
+
+func (c *Catalog) weaponIndex(name string) int {
+	for i := range c.Weapons {
+		if strings.EqualFold(c.Weapons[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) armorIndex(name string) int {
+	for i := range c.Armor {
+		if strings.EqualFold(c.Armor[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) gearIndex(name string) int {
+	for i := range c.Gear {
+		if strings.EqualFold(c.Gear[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) classIndex(name string) int {
+	for i := range c.Classes {
+		if strings.EqualFold(c.Classes[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) critTableIndex(name string) int {
+	for i := range c.CritTables {
+		if strings.EqualFold(c.CritTables[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (c *Catalog) thiefSkillIndex(name string) int {
+	for i := range c.ThiefSkills {
+		if strings.EqualFold(c.ThiefSkills[i].Name, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// OccupationForRoll returns the occupation for a d100 roll
+func (c *Catalog) OccupationForRoll(roll int) (*Occupation, error) {
+	for i := range c.Occupations {
+		if roll >= c.Occupations[i].MinRoll && roll <= c.Occupations[i].MaxRoll {
+			return &c.Occupations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no occupation for roll %d", roll)
+}
+
+// LuckSignForRoll returns the birth augur for a d30 roll
+func (c *Catalog) LuckSignForRoll(roll int) (*LuckSign, error) {
+	for i := range c.LuckSigns {
+		if c.LuckSigns[i].Roll == roll {
+			return &c.LuckSigns[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no luck sign for roll %d", roll)
+}
+
+// GetOccupation looks up an occupation by name, ignoring case
+func (c *Catalog) GetOccupation(name string) (*Occupation, error) {
+	for i := range c.Occupations {
+		if strings.EqualFold(c.Occupations[i].Name, name) {
+			return &c.Occupations[i], nil
+		}
+	}
+	return nil, fmt.Errorf("occupation '%s' not found", name)
+}
+
+// GetWeapon looks up a weapon by name, ignoring case
+func (c *Catalog) GetWeapon(name string) (*Weapon, error) {
+	if i := c.weaponIndex(name); i != -1 {
+		return &c.Weapons[i], nil
+	}
+	return nil, fmt.Errorf("weapon '%s' not found", name)
+}
+
+// GetArmor looks up armor by name, ignoring case
+func (c *Catalog) GetArmor(name string) (*Armor, error) {
+	if i := c.armorIndex(name); i != -1 {
+		return &c.Armor[i], nil
+	}
+	return nil, fmt.Errorf("armor '%s' not found", name)
+}
+
+// GetGear looks up gear by name, ignoring case
+func (c *Catalog) GetGear(name string) (*Gear, error) {
+	if i := c.gearIndex(name); i != -1 {
+		return &c.Gear[i], nil
+	}
+	return nil, fmt.Errorf("gear '%s' not found", name)
+}
+
+// GetClass looks up a class progression by name, ignoring case
+func (c *Catalog) GetClass(name string) (*ClassProgression, error) {
+	if i := c.classIndex(name); i != -1 {
+		return &c.Classes[i], nil
+	}
+	return nil, fmt.Errorf("class '%s' not found", name)
+}
+
+// GetCritTable looks up a crit table by name ("I" through "V"), ignoring case
+func (c *Catalog) GetCritTable(name string) (*ResultTable, error) {
+	if i := c.critTableIndex(strings.TrimSpace(name)); i != -1 {
+		return &c.CritTables[i], nil
+	}
+	return nil, fmt.Errorf("crit table '%s' not found", name)
+}
+
+// GetFumbleTable returns the fumble table
+func (c *Catalog) GetFumbleTable() (*ResultTable, error) {
+	if c.FumbleTable == nil {
+		return nil, fmt.Errorf("no fumble table in catalog")
+	}
+	return c.FumbleTable, nil
+}
+
+// GetThiefSkill looks up a thief skill by name, ignoring case
+func (c *Catalog) GetThiefSkill(name string) (*ThiefSkill, error) {
+	if i := c.thiefSkillIndex(strings.TrimSpace(name)); i != -1 {
+		return &c.ThiefSkills[i], nil
+	}
+	return nil, fmt.Errorf("thief skill '%s' not found", name)
+}
+
+// Search returns every entry whose name (or, for luck signs, effect) contains the query, ignoring case
+func (c *Catalog) Search(query string) *SearchResults {
+	q := strings.ToLower(strings.TrimSpace(query))
+	matches := func(values ...string) bool {
+		for _, v := range values {
+			if strings.Contains(strings.ToLower(v), q) {
+				return true
+			}
+		}
+		return false
+	}
+
+	results := &SearchResults{
+		Occupations: []Occupation{},
+		LuckSigns:   []LuckSign{},
+		Weapons:     []Weapon{},
+		Armor:       []Armor{},
+		Gear:        []Gear{},
+	}
+
+	for _, o := range c.Occupations {
+		if matches(o.Name, o.TrainedWeapon, o.TradeGoods) {
+			results.Occupations = append(results.Occupations, o)
+		}
+	}
+	for _, s := range c.LuckSigns {
+		if matches(s.Name, s.Affects) {
+			results.LuckSigns = append(results.LuckSigns, s)
+		}
+	}
+	for _, w := range c.Weapons {
+		if matches(w.Name) {
+			results.Weapons = append(results.Weapons, w)
+		}
+	}
+	for _, a := range c.Armor {
+		if matches(a.Name) {
+			results.Armor = append(results.Armor, a)
+		}
+	}
+	for _, g := range c.Gear {
+		if matches(g.Name) {
+			results.Gear = append(results.Gear, g)
+		}
+	}
+
+	return results
+}
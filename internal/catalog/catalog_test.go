@@ -0,0 +1,100 @@
+package catalog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateOccupations(t *testing.T) {
+	c, err := Default()
+	if err != nil {
+		t.Fatalf("Default returned error: %v", err)
+	}
+	if err := validateOccupations(c.Occupations); err != nil {
+		t.Errorf("default occupations: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		occupations []Occupation
+	}{
+		{"whole table", []Occupation{{MinRoll: 1, MaxRoll: 100, Name: "Farmer"}}},
+		{"out of order", []Occupation{{MinRoll: 51, MaxRoll: 100, Name: "Miller"}, {MinRoll: 1, MaxRoll: 50, Name: "Farmer"}}},
+	}
+
+	for _, tt := range tests {
+		if err := validateOccupations(tt.occupations); err != nil {
+			t.Errorf("validateOccupations(%s) returned error: %v", tt.name, err)
+		}
+	}
+}
+
+func TestValidateOccupationsRejects(t *testing.T) {
+	tests := []struct {
+		name        string
+		occupations []Occupation
+	}{
+		{"starts above 1", []Occupation{{MinRoll: 2, MaxRoll: 100, Name: "Farmer"}}},
+		{"starts below 1", []Occupation{{MinRoll: 0, MaxRoll: 100, Name: "Farmer"}}},
+		{"stops short", []Occupation{{MinRoll: 1, MaxRoll: 99, Name: "Farmer"}}},
+		{"runs past 100", []Occupation{{MinRoll: 1, MaxRoll: 101, Name: "Farmer"}}},
+		{"gap", []Occupation{{MinRoll: 1, MaxRoll: 40, Name: "Farmer"}, {MinRoll: 42, MaxRoll: 100, Name: "Miller"}}},
+		{"overlap", []Occupation{{MinRoll: 1, MaxRoll: 50, Name: "Farmer"}, {MinRoll: 50, MaxRoll: 100, Name: "Miller"}}},
+		{"inverted range", []Occupation{{MinRoll: 1, MaxRoll: 50, Name: "Farmer"}, {MinRoll: 100, MaxRoll: 51, Name: "Miller"}}},
+	}
+
+	for _, tt := range tests {
+		if err := validateOccupations(tt.occupations); err == nil {
+			t.Errorf("validateOccupations(%s) succeeded, want error", tt.name)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		override string
+		wantErr  bool
+		wantName string // Occupation for a roll of 1
+	}{
+		{"missing override", "", false, ""},
+		{"full occupation table", `{"occupations": [{"minRoll": 1, "maxRoll": 100, "name": "Rat-catcher"}]}`, false, "Rat-catcher"},
+		{"partial occupation table", `{"occupations": [{"minRoll": 1, "maxRoll": 50, "name": "Rat-catcher"}]}`, true, ""},
+		{"malformed JSON", `{"occupations": [`, true, ""},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(dir, "missing.json")
+			if tt.override != "" {
+				path = filepath.Join(dir, fmt.Sprintf("override-%d.json", i))
+				if err := os.WriteFile(path, []byte(tt.override), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			c, err := Load(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Load succeeded, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load returned error: %v", err)
+			}
+
+			occupation, err := c.OccupationForRoll(1)
+			if err != nil {
+				t.Fatalf("OccupationForRoll(1) returned error: %v", err)
+			}
+			if tt.wantName != "" && occupation.Name != tt.wantName {
+				t.Errorf("OccupationForRoll(1) = %q, want %q", occupation.Name, tt.wantName)
+			}
+		})
+	}
+}
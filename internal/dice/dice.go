@@ -0,0 +1,256 @@
+package dice
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Chain is the DCC dice chain, from smallest to largest die
+var Chain = []int{3, 4, 5, 6, 7, 8, 10, 12, 14, 16, 20, 24, 30}
+
+// Limits on a dice expression, so a typo like "999999999d6" is rejected
+// instead of freezing the app
+const (
+	MaxDice  = 100  // Dice in one expression, across all terms
+	MaxSides = 1000 // Sides on a single die
+)
+
+// Term represents a group of identical dice in an expression, e.g. "2d6"
+type Term struct {
+	Count    int  `json:"count"`
+	Sides    int  `json:"sides"`
+	Negative bool `json:"negative"`
+}
+
+// Expression represents a parsed dice expression such as "1d20+1d14" or "1d8+2"
+type Expression struct {
+	Terms    []Term `json:"terms"`
+	Modifier int    `json:"modifier"`
+}
+
+// Parse parses a dice expression. Dice may omit the count ("d24"), and
+// any number of dice terms and flat modifiers may be joined with + or -.
+func Parse(expr string) (*Expression, error) {
+	s := strings.ToLower(strings.ReplaceAll(expr, " ", ""))
+	if s == "" {
+		return nil, fmt.Errorf("empty dice expression")
+	}
+
+	result := &Expression{}
+	negative := false
+	start := 0
+
+	if s[0] == '+' || s[0] == '-' {
+		negative = s[0] == '-'
+		start = 1
+	}
+
+	for i := start; i <= len(s); i++ {
+		if i < len(s) && s[i] != '+' && s[i] != '-' {
+			continue
+		}
+
+		part := s[start:i]
+		if part == "" {
+			return nil, fmt.Errorf("invalid dice expression '%s'", expr)
+		}
+
+		if err := result.addPart(part, negative); err != nil {
+			return nil, fmt.Errorf("invalid dice expression '%s': %v", expr, err)
+		}
+
+		if i < len(s) {
+			negative = s[i] == '-'
+		}
+		start = i + 1
+	}
+
+	if len(result.Terms) == 0 {
+		return nil, fmt.Errorf("dice expression '%s' has no dice", expr)
+	}
+
+	count := 0
+	for _, term := range result.Terms {
+		count += term.Count
+	}
+	if count > MaxDice {
+		return nil, fmt.Errorf("dice expression '%s' rolls %d dice, the most is %d", expr, count, MaxDice)
+	}
+
+	return result, nil
+}
+
+// addPart adds a single "NdS" or constant part to the expression
+func (e *Expression) addPart(part string, negative bool) error {
+	idx := strings.Index(part, "d")
+	if idx == -1 {
+		value, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("bad modifier '%s'", part)
+		}
+		if negative {
+			value = -value
+		}
+		e.Modifier += value
+		return nil
+	}
+
+	count := 1
+	if idx > 0 {
+		n, err := strconv.Atoi(part[:idx])
+		if err != nil || n < 1 {
+			return fmt.Errorf("bad dice count '%s'", part[:idx])
+		}
+		if n > MaxDice {
+			return fmt.Errorf("too many dice '%s', the most is %d", part[:idx], MaxDice)
+		}
+		count = n
+	}
+
+	sidesText := part[idx+1:]
+	if sidesText == "%" {
+		sidesText = "100"
+	}
+	sides, err := strconv.Atoi(sidesText)
+	if err != nil || sides < 1 {
+		return fmt.Errorf("bad die size '%s'", part[idx+1:])
+	}
+	if sides > MaxSides {
+		return fmt.Errorf("die size '%s' is too large, the most is d%d", part[idx+1:], MaxSides)
+	}
+
+	e.Terms = append(e.Terms, Term{Count: count, Sides: sides, Negative: negative})
+	return nil
+}
+
+// String formats the expression in canonical form, e.g. "1d20+1d14+2"
+func (e *Expression) String() string {
+	var builder strings.Builder
+
+	for i, term := range e.Terms {
+		if term.Negative {
+			builder.WriteString("-")
+		} else if i > 0 {
+			builder.WriteString("+")
+		}
+		builder.WriteString(fmt.Sprintf("%dd%d", term.Count, term.Sides))
+	}
+
+	if e.Modifier > 0 {
+		builder.WriteString(fmt.Sprintf("+%d", e.Modifier))
+	} else if e.Modifier < 0 {
+		builder.WriteString(fmt.Sprintf("%d", e.Modifier))
+	}
+
+	return builder.String()
+}
+
+// Min returns the lowest possible total of the expression
+func (e *Expression) Min() int {
+	total := e.Modifier
+	for _, term := range e.Terms {
+		if term.Negative {
+			total -= term.Count * term.Sides
+		} else {
+			total += term.Count
+		}
+	}
+	return total
+}
+
+// Max returns the highest possible total of the expression
+func (e *Expression) Max() int {
+	total := e.Modifier
+	for _, term := range e.Terms {
+		if term.Negative {
+			total -= term.Count
+		} else {
+			total += term.Count * term.Sides
+		}
+	}
+	return total
+}
+
+// ChainIndex returns the position of a die on the dice chain, or -1 if it is not on the chain
+func ChainIndex(sides int) int {
+	for i, s := range Chain {
+		if s == sides {
+			return i
+		}
+	}
+	return -1
+}
+
+// StepDie moves a die up (positive steps) or down (negative steps) the dice chain.
+// The result is clamped to the ends of the chain (d3 and d30).
+func StepDie(sides, steps int) (int, error) {
+	idx := ChainIndex(sides)
+	if idx == -1 {
+		return 0, fmt.Errorf("d%d is not on the dice chain", sides)
+	}
+
+	idx += steps
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(Chain) {
+		idx = len(Chain) - 1
+	}
+
+	return Chain[idx], nil
+}
+
+// Step returns a copy of the expression with every die moved along the dice chain
+func (e *Expression) Step(steps int) (*Expression, error) {
+	stepped := &Expression{Modifier: e.Modifier}
+	for _, term := range e.Terms {
+		sides, err := StepDie(term.Sides, steps)
+		if err != nil {
+			return nil, err
+		}
+		term.Sides = sides
+		stepped.Terms = append(stepped.Terms, term)
+	}
+	return stepped, nil
+}
+
+// StepExpression parses an expression and moves every die along the dice chain
+func StepExpression(expr string, steps int) (string, error) {
+	parsed, err := Parse(expr)
+	if err != nil {
+		return "", err
+	}
+
+	stepped, err := parsed.Step(steps)
+	if err != nil {
+		return "", err
+	}
+
+	return stepped.String(), nil
+}
+
+// ParseActionDice splits an action dice string such as "1d20+1d14" into the
+// individual action dice. Each action die is rolled separately, not summed.
+func ParseActionDice(actionDice string) ([]int, error) {
+	parsed, err := Parse(strings.ReplaceAll(actionDice, ",", "+"))
+	if err != nil {
+		return nil, err
+	}
+
+	if parsed.Modifier != 0 {
+		return nil, fmt.Errorf("action dice '%s' cannot include a modifier", actionDice)
+	}
+
+	var dice []int
+	for _, term := range parsed.Terms {
+		if term.Negative {
+			return nil, fmt.Errorf("action dice '%s' cannot be negative", actionDice)
+		}
+		for i := 0; i < term.Count; i++ {
+			dice = append(dice, term.Sides)
+		}
+	}
+
+	return dice, nil
+}
@@ -0,0 +1,180 @@
+package dice
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr     string
+		terms    []Term
+		modifier int
+	}{
+		{"1d20", []Term{{Count: 1, Sides: 20}}, 0},
+		{"d24", []Term{{Count: 1, Sides: 24}}, 0},
+		{"D6", []Term{{Count: 1, Sides: 6}}, 0},
+		{"1d8+2", []Term{{Count: 1, Sides: 8}}, 2},
+		{"1d6-1", []Term{{Count: 1, Sides: 6}}, -1},
+		{"1d20+1d14", []Term{{Count: 1, Sides: 20}, {Count: 1, Sides: 14}}, 0},
+		{" 2d6 + 1d4 - 3 ", []Term{{Count: 2, Sides: 6}, {Count: 1, Sides: 4}}, -3},
+		{"1d20-1d4", []Term{{Count: 1, Sides: 20}, {Count: 1, Sides: 4, Negative: true}}, 0},
+		{"-1d4+2", []Term{{Count: 1, Sides: 4, Negative: true}}, 2},
+		{"d%", []Term{{Count: 1, Sides: 100}}, 0},
+		{"2+1d3+1", []Term{{Count: 1, Sides: 3}}, 3},
+		{"100d6", []Term{{Count: 100, Sides: 6}}, 0},
+		{"1d1000", []Term{{Count: 1, Sides: 1000}}, 0},
+	}
+
+	for _, tt := range tests {
+		parsed, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(parsed.Terms, tt.terms) {
+			t.Errorf("Parse(%q) terms = %+v, want %+v", tt.expr, parsed.Terms, tt.terms)
+		}
+		if parsed.Modifier != tt.modifier {
+			t.Errorf("Parse(%q) modifier = %d, want %d", tt.expr, parsed.Modifier, tt.modifier)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tests := []string{
+		"",
+		"   ",
+		"5",
+		"+3",
+		"1d",
+		"d",
+		"0d6",
+		"1d0",
+		"xd6",
+		"1dx",
+		"1d6+",
+		"1d6++2",
+		"1d20+abc",
+		"101d6",
+		"999999999d6",
+		"99999999999999999999d6",
+		"1d1001",
+		"1d999999999",
+		"60d6+60d6",
+	}
+
+	for _, expr := range tests {
+		if parsed, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) = %+v, want error", expr, parsed)
+		}
+	}
+}
+
+func TestExpressionString(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"d20", "1d20"},
+		{"1d20 + 1d14 + 2", "1d20+1d14+2"},
+		{"-1d4-1", "-1d4-1"},
+		{"d%", "1d100"},
+	}
+
+	for _, tt := range tests {
+		parsed, err := Parse(tt.expr)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error: %v", tt.expr, err)
+		}
+		if got := parsed.String(); got != tt.want {
+			t.Errorf("Parse(%q).String() = %q, want %q", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		expr  string
+		steps int
+		want  string
+	}{
+		{"1d20", 1, "1d24"},
+		{"1d20", -1, "1d16"},
+		{"1d20+1d14", 1, "1d24+1d16"},
+		{"2d6+1", -2, "2d4+1"},
+		{"1d7", 1, "1d8"},
+		{"1d30", 1, "1d30"},
+		{"1d3", -1, "1d3"},
+		{"1d10", 10, "1d30"},
+		{"1d10", -10, "1d3"},
+		{"1d8", 0, "1d8"},
+	}
+
+	for _, tt := range tests {
+		got, err := StepExpression(tt.expr, tt.steps)
+		if err != nil {
+			t.Errorf("StepExpression(%q, %d) returned error: %v", tt.expr, tt.steps, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("StepExpression(%q, %d) = %q, want %q", tt.expr, tt.steps, got, tt.want)
+		}
+	}
+}
+
+func TestStepRejects(t *testing.T) {
+	tests := []string{
+		"1d9",
+		"1d100",
+		"d%",
+		"1d20+1d2",
+		"bad",
+	}
+
+	for _, expr := range tests {
+		if got, err := StepExpression(expr, 1); err == nil {
+			t.Errorf("StepExpression(%q, 1) = %q, want error", expr, got)
+		}
+	}
+}
+
+func TestParseActionDice(t *testing.T) {
+	tests := []struct {
+		actionDice string
+		want       []int
+	}{
+		{"1d20", []int{20}},
+		{"d20", []int{20}},
+		{"1d20+1d14", []int{20, 14}},
+		{"1d20, 1d16", []int{20, 16}},
+		{"2d20", []int{20, 20}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseActionDice(tt.actionDice)
+		if err != nil {
+			t.Errorf("ParseActionDice(%q) returned error: %v", tt.actionDice, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseActionDice(%q) = %v, want %v", tt.actionDice, got, tt.want)
+		}
+	}
+}
+
+func TestParseActionDiceRejects(t *testing.T) {
+	tests := []string{
+		"",
+		"1d20+1",
+		"1d20-1d4",
+		"101d20",
+		"1d20,",
+	}
+
+	for _, actionDice := range tests {
+		if got, err := ParseActionDice(actionDice); err == nil {
+			t.Errorf("ParseActionDice(%q) = %v, want error", actionDice, got)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package dice
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// DieResult represents the outcome of a single die
+type DieResult struct {
+	Sides    int  `json:"sides"`
+	Value    int  `json:"value"`
+	Negative bool `json:"negative"`
+}
+
+// Result represents the outcome of rolling a dice expression
+type Result struct {
+	Expression string      `json:"expression"`
+	Dice       []DieResult `json:"dice"`
+	Modifier   int         `json:"modifier"`
+	Total      int         `json:"total"`
+}
+
+// Natural returns the value of the first die, which is the natural roll for checks
+func (r *Result) Natural() int {
+	if len(r.Dice) == 0 {
+		return 0
+	}
+	return r.Dice[0].Value
+}
+
+// Roller rolls dice using its own random source so rolls can be seeded and reproduced
+type Roller struct {
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+// NewRoller creates a roller seeded from the current time
+func NewRoller() *Roller {
+	return NewSeededRoller(time.Now().UnixNano())
+}
+
+// NewSeededRoller creates a roller with a fixed seed, producing repeatable rolls
+func NewSeededRoller(seed int64) *Roller {
+	return &Roller{
+		rng: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// RollDie rolls a single die with the given number of sides
+func (r *Roller) RollDie(sides int) int {
+	if sides < 1 {
+		return 0
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.rng.Intn(sides) + 1
+}
+
+// Roll parses and rolls a dice expression
+func (r *Roller) Roll(expr string) (*Result, error) {
+	parsed, err := Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.RollExpression(parsed), nil
+}
+
+// RollExpression rolls an already parsed expression
+func (r *Roller) RollExpression(expr *Expression) *Result {
+	result := &Result{
+		Expression: expr.String(),
+		Dice:       []DieResult{},
+		Modifier:   expr.Modifier,
+		Total:      expr.Modifier,
+	}
+
+	for _, term := range expr.Terms {
+		for i := 0; i < term.Count; i++ {
+			value := r.RollDie(term.Sides)
+			result.Dice = append(result.Dice, DieResult{
+				Sides:    term.Sides,
+				Value:    value,
+				Negative: term.Negative,
+			})
+
+			if term.Negative {
+				result.Total -= value
+			} else {
+				result.Total += value
+			}
+		}
+	}
+
+	return result
+}
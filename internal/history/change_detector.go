@@ -2,6 +2,8 @@ package history
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/austinkempa/dcc-character-sheet/internal/models"
 )
@@ -39,6 +41,36 @@ func (cd *ChangeDetector) DetectCharacterChanges(old, new *models.Character) []s
 		changes = append(changes, fmt.Sprintf("Max health changed from %d to %d", old.MaxHealth, new.MaxHealth))
 	}
 
+	if old.Life.State != new.Life.State {
+		changes = append(changes, fmt.Sprintf("Life state changed from '%s' to '%s'", lifeState(old), lifeState(new)))
+	}
+
+	if old.Class != new.Class {
+		changes = append(changes, fmt.Sprintf("Class changed from '%s' to '%s'", old.Class, new.Class))
+	}
+
+	if old.Attack != new.Attack {
+		changes = append(changes, fmt.Sprintf("Attack bonus changed from %d to %d", old.Attack, new.Attack))
+	}
+
+	if old.ActionDice != new.ActionDice {
+		changes = append(changes, fmt.Sprintf("Action dice changed from '%s' to '%s'", old.ActionDice, new.ActionDice))
+	}
+
+	if old.CritDice != new.CritDice || old.CritTable != new.CritTable {
+		changes = append(changes, fmt.Sprintf("Crit changed: %s/%s → %s/%s", old.CritDice, old.CritTable, new.CritDice, new.CritTable))
+	}
+
+	if old.Saves != new.Saves {
+		changes = append(changes, fmt.Sprintf("Saves changed: Ref %d/Fort %d/Will %d → Ref %d/Fort %d/Will %d",
+			old.Saves.Reflex, old.Saves.Fortitude, old.Saves.Willpower,
+			new.Saves.Reflex, new.Saves.Fortitude, new.Saves.Willpower))
+	}
+
+	if old.ClassState.Disapproval != new.ClassState.Disapproval {
+		changes = append(changes, fmt.Sprintf("Disapproval range changed from %d to %d", disapprovalRange(old), disapprovalRange(new)))
+	}
+
 	if old.TotalExperience != new.TotalExperience {
 		diff := new.TotalExperience - old.TotalExperience
 		changes = append(changes, fmt.Sprintf("Experience gained: %d (total: %d)", diff, new.TotalExperience))
@@ -69,6 +101,10 @@ func (cd *ChangeDetector) DetectCharacterChanges(old, new *models.Character) []s
 		changes = append(changes, fmt.Sprintf("Luck changed: %d/%d → %d/%d", old.Luck.Base, old.Luck.Temporary, new.Luck.Base, new.Luck.Temporary))
 	}
 
+	if old.Purse != new.Purse {
+		changes = append(changes, fmt.Sprintf("Purse changed: %s → %s", formatPurse(old.Purse), formatPurse(new.Purse)))
+	}
+
 	// Equipment changes
 	changes = append(changes, cd.DetectEquipmentChanges(old.Equipment, new.Equipment)...)
 
@@ -78,9 +114,47 @@ func (cd *ChangeDetector) DetectCharacterChanges(old, new *models.Character) []s
 	// Class changes
 	changes = append(changes, cd.DetectClassChanges(old.Classes, new.Classes)...)
 
+	// Condition changes
+	changes = append(changes, cd.DetectConditionChanges(old.Conditions, new.Conditions)...)
+
 	return changes
 }
 
+// lifeState returns a character's life state, treating an unset state as alive
+func lifeState(character *models.Character) string {
+	if character.Life.State == "" {
+		return models.LifeStateAlive
+	}
+	return character.Life.State
+}
+
+// disapprovalRange returns a cleric's disapproval range, treating an unset range as 1
+func disapprovalRange(character *models.Character) int {
+	if character.ClassState.Disapproval < 1 {
+		return 1
+	}
+	return character.ClassState.Disapproval
+}
+
+// formatPurse describes a purse's coins, e.g. "3 gp, 5 sp"
+func formatPurse(purse models.Purse) string {
+	var parts []string
+	for _, coin := range []struct {
+		count int
+		name  string
+	}{
+		{purse.PP, "pp"}, {purse.EP, "ep"}, {purse.GP, "gp"}, {purse.SP, "sp"}, {purse.CP, "cp"},
+	} {
+		if coin.count != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", coin.count, coin.name))
+		}
+	}
+	if len(parts) == 0 {
+		return "empty"
+	}
+	return strings.Join(parts, ", ")
+}
+
 // DetectEquipmentChanges compares equipment lists and returns changes
 func (cd *ChangeDetector) DetectEquipmentChanges(old, new []models.Equipment) []string {
 	var changes []string
@@ -146,6 +220,12 @@ func (cd *ChangeDetector) DetectAbilityChanges(old, new []models.Ability) []stri
 			}
 		} else if oldItem.Name != newItem.Name {
 			changes = append(changes, fmt.Sprintf("Ability renamed: '%s' → '%s'", oldItem.Name, newItem.Name))
+		} else if oldItem.Lost != newItem.Lost {
+			if newItem.Lost {
+				changes = append(changes, fmt.Sprintf("Spell lost: %s", newItem.Name))
+			} else {
+				changes = append(changes, fmt.Sprintf("Spell regained: %s", newItem.Name))
+			}
 		} else if oldItem.IsActive != newItem.IsActive {
 			if newItem.IsActive {
 				changes = append(changes, fmt.Sprintf("Ability restored: %s", newItem.Name))
@@ -213,3 +293,153 @@ func (cd *ChangeDetector) DetectClassChanges(old, new []models.Class) []string {
 
 	return changes
 }
+
+// DetectConditionChanges compares condition lists and returns changes
+func (cd *ChangeDetector) DetectConditionChanges(old, new []models.Condition) []string {
+	var changes []string
+
+	oldMap := make(map[string]models.Condition)
+	for _, item := range old {
+		oldMap[item.ID] = item
+	}
+
+	newMap := make(map[string]models.Condition)
+	for _, item := range new {
+		newMap[item.ID] = item
+	}
+
+	for _, newItem := range new {
+		if _, exists := oldMap[newItem.ID]; !exists {
+			if newItem.Source != "" {
+				changes = append(changes, fmt.Sprintf("Condition added: %s (%s)", newItem.Name, newItem.Source))
+			} else {
+				changes = append(changes, fmt.Sprintf("Condition added: %s", newItem.Name))
+			}
+		}
+	}
+
+	for _, oldItem := range old {
+		if _, exists := newMap[oldItem.ID]; !exists {
+			changes = append(changes, fmt.Sprintf("Condition ended: %s", oldItem.Name))
+		}
+	}
+
+	return changes
+}
+
+// DetectPartyChanges compares old and new party and returns list of changes
+func (cd *ChangeDetector) DetectPartyChanges(old, new *models.Party) []string {
+	var changes []string
+
+	if old.Name != new.Name {
+		changes = append(changes, fmt.Sprintf("Name changed from '%s' to '%s'", old.Name, new.Name))
+	}
+
+	if old.Description != new.Description {
+		changes = append(changes, "Description changed")
+	}
+
+	if old.IsActive != new.IsActive {
+		if new.IsActive {
+			changes = append(changes, "Party restored")
+		} else {
+			changes = append(changes, "Party deleted")
+		}
+	}
+
+	changes = append(changes, cd.DetectMemberChanges(old, new)...)
+
+	if marchingOrder(old) != marchingOrder(new) {
+		changes = append(changes, fmt.Sprintf("Marching order changed: %s → %s", marchingOrder(old), marchingOrder(new)))
+	}
+
+	// Stash changes
+	for _, change := range cd.DetectEquipmentChanges(old.Stash, new.Stash) {
+		changes = append(changes, "Stash: "+change)
+	}
+
+	if old.StashPurse != new.StashPurse {
+		changes = append(changes, fmt.Sprintf("Stash purse changed: %s → %s", formatPurse(old.StashPurse), formatPurse(new.StashPurse)))
+	}
+
+	return changes
+}
+
+// DetectMemberChanges compares party rosters and returns joins, departures and role changes
+func (cd *ChangeDetector) DetectMemberChanges(old, new *models.Party) []string {
+	var changes []string
+
+	oldMap := make(map[string]models.PartyMember)
+	for _, member := range old.Members {
+		oldMap[member.CharacterID] = member
+	}
+
+	newMap := make(map[string]models.PartyMember)
+	for _, member := range new.Members {
+		newMap[member.CharacterID] = member
+	}
+
+	for _, newMember := range new.Members {
+		oldMember, exists := oldMap[newMember.CharacterID]
+		if !exists {
+			changes = append(changes, fmt.Sprintf("Joined: %s", memberName(newMember)))
+			continue
+		}
+		if oldMember.Role != newMember.Role {
+			switch {
+			case newMember.Role == "":
+				changes = append(changes, fmt.Sprintf("%s is no longer %s", memberName(newMember), oldMember.Role))
+			case oldMember.Role == "":
+				changes = append(changes, fmt.Sprintf("%s is now %s", memberName(newMember), newMember.Role))
+			default:
+				changes = append(changes, fmt.Sprintf("%s changed role from %s to %s", memberName(newMember), oldMember.Role, newMember.Role))
+			}
+		}
+	}
+
+	for _, oldMember := range old.Members {
+		if _, exists := newMap[oldMember.CharacterID]; exists {
+			continue
+		}
+		reason := models.PartyLeftLeft
+		for i := len(new.FormerMembers) - 1; i >= 0; i-- {
+			if new.FormerMembers[i].CharacterID == oldMember.CharacterID {
+				reason = new.FormerMembers[i].Reason
+				break
+			}
+		}
+		changes = append(changes, fmt.Sprintf("Left: %s (%s)", memberName(oldMember), reason))
+	}
+
+	return changes
+}
+
+// marchingOrder describes a party's marching order, front first, e.g. "Ana, Bors"
+func marchingOrder(party *models.Party) string {
+	var placed []models.PartyMember
+	for _, member := range party.Members {
+		if member.Position > 0 {
+			placed = append(placed, member)
+		}
+	}
+	sort.SliceStable(placed, func(i, j int) bool {
+		return placed[i].Position < placed[j].Position
+	})
+
+	var names []string
+	for _, member := range placed {
+		names = append(names, memberName(member))
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+// memberName returns a member's name, falling back to their character ID
+func memberName(member models.PartyMember) string {
+	if member.CharacterName != "" {
+		return member.CharacterName
+	}
+	return member.CharacterID
+}
@@ -7,6 +7,7 @@ type Character struct {
 	ID                   string           `json:"id"`
 	Name                 string           `json:"name"`
 	Occupation           string           `json:"occupation"`
+	BirthAugur           string           `json:"birthAugur"` // Luck sign rolled at creation
 	Level                int              `json:"level"`
 	Class                string           `json:"class"`
 	ClassDescription     string           `json:"classDescription"`
@@ -20,6 +21,7 @@ type Character struct {
 	Speed                int              `json:"speed"`
 	Initiative           int              `json:"initiative"`
 	IsActive             bool             `json:"isActive"`
+	Life                 LifeStatus       `json:"life"` // Alive, dying or dead; separate from soft deletion
 	Strength             Attribute        `json:"strength"`
 	Agility              Attribute        `json:"agility"`
 	Stamina              Attribute        `json:"stamina"`
@@ -28,6 +30,7 @@ type Character struct {
 	Luck                 Attribute        `json:"luck"`
 	Saves                Saves            `json:"saves"`
 	Notes                string           `json:"notes"`
+	Purse                Purse            `json:"purse"`
 	Equipment            []Equipment      `json:"equipment"`
 	Abilities            []Ability        `json:"abilities"`
 	Classes              []Class          `json:"classes"`
@@ -41,10 +44,16 @@ type Character struct {
 	MissileAttackBonus   int              `json:"missileAttackBonus"`
 	MissileDamageBonus   int              `json:"missileDamageBonus"`
 	ImageFilename        string           `json:"imageFilename"` // Filename of character image
+	LuckBurned           int              `json:"luckBurned"`    // Burned Luck that thieves and halflings can still regenerate
+	LuckLog              []LuckEvent      `json:"luckLog"`
+	Spellburn            SpellburnState   `json:"spellburn"`
+	Conditions           []Condition      `json:"conditions"`
+	ClassState           ClassState       `json:"classState"`
 	History              []HistoryEntry   `json:"history"`
 }
 
-// Attribute represents a character attribute with base and temporary values
+// Attribute represents a character attribute with base and temporary values.
+// A Temporary of 0 means the attribute is at its base score.
 type Attribute struct {
 	Base      int `json:"base"`
 	Temporary int `json:"temporary"`
@@ -59,31 +68,39 @@ type Saves struct {
 
 // Equipment represents an equipment item
 type Equipment struct {
-	ID            string  `json:"id"`
-	Name          string  `json:"name"`
-	Quantity      int     `json:"quantity"`
-	Weight        float64 `json:"weight"`
-	Value         float64 `json:"value"`
-	Category      string  `json:"category"`
-	Equipped      bool    `json:"equipped"`
-	ACBonus       int     `json:"acBonus"`
-	ReflexSave    int     `json:"reflexSave"`
-	FortitudeSave int     `json:"fortitudeSave"`
-	WillpowerSave int     `json:"willpowerSave"`
-	DamageDice    string  `json:"damageDice"`    // For weapons
-	AttackBonus   int     `json:"attackBonus"`   // For weapons
-	Description   string  `json:"description"`
-	IsActive      bool    `json:"isActive"`
+	ID                   string  `json:"id"`
+	Name                 string  `json:"name"`
+	Quantity             int     `json:"quantity"`
+	Weight               float64 `json:"weight"`
+	Value                float64 `json:"value"`
+	Category             string  `json:"category"`
+	Equipped             bool    `json:"equipped"`
+	ACBonus              int     `json:"acBonus"`
+	CheckPenalty         int     `json:"checkPenalty"`         // For armor
+	CheckPenaltyOverride bool    `json:"checkPenaltyOverride"` // CheckPenalty was set by hand and is used even when 0
+	FumbleDie            string  `json:"fumbleDie"`            // For armor
+	ReflexSave           int     `json:"reflexSave"`
+	FortitudeSave        int     `json:"fortitudeSave"`
+	WillpowerSave        int     `json:"willpowerSave"`
+	DamageDice           string  `json:"damageDice"`  // For weapons
+	AttackBonus          int     `json:"attackBonus"` // For weapons
+	Description          string  `json:"description"`
+	IsActive             bool    `json:"isActive"`
 }
 
 // Ability represents a character ability or spell
 type Ability struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	Type        string `json:"type"` // spell, ability, trait, etc.
-	PageNumber  string `json:"pageNumber"`
-	IsActive    bool   `json:"isActive"`
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	Type            string `json:"type"` // spell, ability, trait, etc.
+	PageNumber      string `json:"pageNumber"`
+	SpellLevel      int    `json:"spellLevel"`      // For spells, defaults to 1
+	CasterLevel     int    `json:"casterLevel"`     // For spells, defaults to the character's level
+	SpellCheckBonus int    `json:"spellCheckBonus"` // For spells, extra bonus on top of caster level and ability modifier
+	ResultsTableID  string `json:"resultsTableId"`  // For spells, optional custom or shared table of results
+	Lost            bool   `json:"lost"`            // For spells, lost until restored by rest
+	IsActive        bool   `json:"isActive"`
 }
 
 // Class represents a character class
@@ -97,16 +114,33 @@ type Class struct {
 
 // HistoryEntry represents a change in the character's history
 type HistoryEntry struct {
-	Timestamp time.Time `json:"timestamp"`
-	Changes   []string  `json:"changes"`
-	Note      string    `json:"note"`
+	Timestamp time.Time    `json:"timestamp"`
+	Changes   []string     `json:"changes"`
+	Note      string       `json:"note"`
+	Link      *HistoryLink `json:"link,omitempty"` // Set when the change was part of a transfer or party action
+}
+
+// HistoryLink ties together the history entries written by one transfer or party action
+type HistoryLink struct {
+	ID          string `json:"id"`
+	CharacterID string `json:"characterId,omitempty"` // The other character involved
+	PartyID     string `json:"partyId,omitempty"`     // The party involved
+	Session     string `json:"session,omitempty"`     // Session the change belongs to
 }
 
 // Table represents a custom table for the character
 type Table struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Number      string `json:"number"`
-	Dice        string `json:"dice"`
-	IsActive    bool   `json:"isActive"`
+	ID          string       `json:"id"`
+	Name        string       `json:"name"`
+	Number      string       `json:"number"`
+	Dice        string       `json:"dice"`
+	Entries     []TableEntry `json:"entries"` // Ordered from lowest to highest roll
+	IsActive    bool         `json:"isActive"`
+}
+
+// TableEntry represents a ranged result on a custom table, e.g. "1-3: Nothing happens"
+type TableEntry struct {
+	Min    int    `json:"min"`
+	Max    int    `json:"max"`
+	Result string `json:"result"`
 }
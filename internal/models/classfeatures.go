@@ -0,0 +1,73 @@
+package models
+
+// ClassState holds class mechanics that persist between checks
+type ClassState struct {
+	Disapproval int `json:"disapproval"` // Cleric disapproval range; 0 means the starting range of 1
+}
+
+// ClassFeatures describes a character's class mechanics at their current level
+type ClassFeatures struct {
+	CharacterID string            `json:"characterId"`
+	Modules     []string          `json:"modules"`     // Classes with feature modules that apply
+	DeedDie     string            `json:"deedDie"`     // Warriors and dwarves
+	ThreatRange int               `json:"threatRange"` // Lowest natural roll that threatens a crit
+	ThiefSkills []ThiefSkillBonus `json:"thiefSkills"`
+	ScrollDie   string            `json:"scrollDie"` // Thieves casting from scrolls
+	LuckDie     string            `json:"luckDie"`   // Thieves burning Luck
+	Disapproval int               `json:"disapproval"`
+}
+
+// ThiefSkillBonus is a thief's total bonus for one skill
+type ThiefSkillBonus struct {
+	Name            string `json:"name"`
+	Bonus           int    `json:"bonus"`
+	Ability         string `json:"ability"`
+	AbilityModifier int    `json:"abilityModifier"`
+	Total           int    `json:"total"`
+}
+
+// MightyDeedResult is the outcome of a warrior's or dwarf's attack with a deed die
+type MightyDeedResult struct {
+	CharacterID   string `json:"characterId"`
+	Missile       bool   `json:"missile"`
+	ActionDie     string `json:"actionDie"`
+	Natural       int    `json:"natural"`
+	DeedDie       string `json:"deedDie"`
+	DeedRoll      int    `json:"deedRoll"`
+	AttackBonus   int    `json:"attackBonus"` // Everything added to the attack except the deed roll
+	AttackTotal   int    `json:"attackTotal"`
+	DamageBonus   int    `json:"damageBonus"` // Deed roll plus damage modifiers
+	DeedSucceeded bool   `json:"deedSucceeded"`
+	Critical      bool   `json:"critical"`
+	Fumble        bool   `json:"fumble"`
+}
+
+// SkillCheckResult is the outcome of a thief skill check
+type SkillCheckResult struct {
+	CharacterID string `json:"characterId"`
+	Skill       string `json:"skill"`
+	Expression  string `json:"expression"`
+	Natural     int    `json:"natural"`
+	Modifier    int    `json:"modifier"`
+	Total       int    `json:"total"`
+	DC          int    `json:"dc"`
+	Success     bool   `json:"success"`
+}
+
+// ClericCheckResult is the outcome of a cleric's lay on hands or turn unholy check
+type ClericCheckResult struct {
+	CharacterID      string `json:"characterId"`
+	Power            string `json:"power"` // "Lay on hands" or "Turn unholy"
+	Expression       string `json:"expression"`
+	Natural          int    `json:"natural"`
+	Modifier         int    `json:"modifier"`
+	Total            int    `json:"total"`
+	Success          bool   `json:"success"`
+	Disapproval      bool   `json:"disapproval"`      // Natural roll fell within the disapproval range
+	DisapprovalRange int    `json:"disapprovalRange"` // Range after the check
+	Outcome          string `json:"outcome"`
+	TargetID         string `json:"targetId,omitempty"`
+	HealingDice      string `json:"healingDice,omitempty"` // Lay on hands, e.g. "2d8"
+	Healed           int    `json:"healed,omitempty"`
+	MaxHitDice       int    `json:"maxHitDice,omitempty"` // Turn unholy: strongest creature affected
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Condition is a timed status effect such as poison, blindness, a curse or a spell
+type Condition struct {
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	Source          string             `json:"source"` // What caused it, e.g. "Giant spider bite"
+	Attributes      AttributeModifiers `json:"attributes"`
+	Saves           Saves              `json:"saves"`
+	ArmorClass      int                `json:"armorClass"`
+	Duration        GameDuration       `json:"duration"`        // A value of 0 lasts until removed
+	RoundsRemaining int                `json:"roundsRemaining"` // Counts down as time advances
+	AppliedAt       time.Time          `json:"appliedAt"`
+}
+
+// AttributeModifiers adjusts each attribute's score while a condition lasts
+type AttributeModifiers struct {
+	Strength     int `json:"strength"`
+	Agility      int `json:"agility"`
+	Stamina      int `json:"stamina"`
+	Personality  int `json:"personality"`
+	Intelligence int `json:"intelligence"`
+	Luck         int `json:"luck"`
+}
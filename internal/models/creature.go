@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// Creature is a monster or NPC with a DCC stat block
+type Creature struct {
+	ID                string           `json:"id"`
+	Name              string           `json:"name"`
+	Description       string           `json:"description"`
+	Initiative        int              `json:"initiative"` // Init modifier
+	Attacks           []CreatureAttack `json:"attacks"`
+	ArmorClass        int              `json:"armorClass"`
+	HitDice           string           `json:"hitDice"`   // e.g. "2d8+2"
+	HitPoints         int              `json:"hitPoints"` // Fixed hit points; 0 rolls HitDice for each one
+	Movement          string           `json:"movement"`  // As written, e.g. "30' or fly 40'"
+	Speed             int              `json:"speed"`     // Base movement in feet
+	ActionDice        string           `json:"actionDice"`
+	SpecialProperties string           `json:"specialProperties"`
+	Saves             Saves            `json:"saves"`
+	Alignment         int              `json:"alignment"` // 0=Neutral, 1=Lawful, 2=Chaotic
+	WorldNoteIDs      []string         `json:"worldNoteIds"`
+	StatBlock         string           `json:"statBlock"` // Original text when parsed from a stat block
+	IsActive          bool             `json:"isActive"`
+	CreatedAt         time.Time        `json:"createdAt"`
+	UpdatedAt         time.Time        `json:"updatedAt"`
+}
+
+// CreatureAttack is one attack from a stat block, e.g. "bite +2 melee (1d6)"
+type CreatureAttack struct {
+	Name   string `json:"name"`
+	Bonus  int    `json:"bonus"`
+	Type   string `json:"type"`   // melee or missile
+	Damage string `json:"damage"` // Damage and any rider, e.g. "1d6 plus poison"
+}
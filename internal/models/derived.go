@@ -0,0 +1,47 @@
+package models
+
+// DerivedAttribute is an attribute with its current score and modifier
+type DerivedAttribute struct {
+	Base     int `json:"base"`
+	Current  int `json:"current"`
+	Modifier int `json:"modifier"`
+}
+
+// DerivedStats holds values calculated from a character by the rules engine
+type DerivedStats struct {
+	CharacterID          string           `json:"characterId"`
+	Strength             DerivedAttribute `json:"strength"`
+	Agility              DerivedAttribute `json:"agility"`
+	Stamina              DerivedAttribute `json:"stamina"`
+	Personality          DerivedAttribute `json:"personality"`
+	Intelligence         DerivedAttribute `json:"intelligence"`
+	Luck                 DerivedAttribute `json:"luck"`
+	ArmorClass           int              `json:"armorClass"`
+	Saves                Saves            `json:"saves"`
+	OutstandingSpellburn Spellburn        `json:"outstandingSpellburn"`
+	Conditions           []string         `json:"conditions"` // Names of active conditions
+	Encumbrance          Encumbrance      `json:"encumbrance"`
+}
+
+// Encumbrance describes what a character carries and how it slows them down.
+// Penalties are negative.
+type Encumbrance struct {
+	CarriedWeight     float64 `json:"carriedWeight"`  // All active equipment
+	EquippedWeight    float64 `json:"equippedWeight"` // Equipped items only, as shown on the equipment tab
+	Capacity          float64 `json:"capacity"`       // Most weight carried without penalty
+	Tier              string  `json:"tier"`
+	ArmorSpeedPenalty int     `json:"armorSpeedPenalty"`
+	SpeedPenalty      int     `json:"speedPenalty"` // Armor and encumbrance combined
+	Speed             int     `json:"speed"`
+	ArmorCheckPenalty int     `json:"armorCheckPenalty"`
+	CheckPenalty      int     `json:"checkPenalty"` // Armor and encumbrance combined
+	FumbleDie         string  `json:"fumbleDie"`
+}
+
+// Spellburn is a number of points per physical attribute
+type Spellburn struct {
+	Strength int `json:"strength"`
+	Agility  int `json:"agility"`
+	Stamina  int `json:"stamina"`
+	Total    int `json:"total"`
+}
@@ -0,0 +1,57 @@
+package models
+
+import "time"
+
+// Encounter is a combat tracked round by round
+type Encounter struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"`
+	PartyID    string           `json:"partyId"`
+	Round      int              `json:"round"` // Starts at 1
+	Turn       int              `json:"turn"`  // Index into Combatants of whoever is acting
+	Combatants []Combatant      `json:"combatants"`
+	Log        []EncounterEvent `json:"log"`
+	Ended      bool             `json:"ended"`
+	EndedAt    time.Time        `json:"endedAt"`
+	IsActive   bool             `json:"isActive"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+}
+
+// Combatant is a PC or NPC in an encounter, in initiative order. PC hit
+// points mirror the character, which is the source of truth.
+type Combatant struct {
+	ID                 string `json:"id"`
+	CharacterID        string `json:"characterId"` // Empty for NPCs
+	CreatureID         string `json:"creatureId"`  // NPCs from a creature stat block
+	Name               string `json:"name"`
+	IsPlayer           bool   `json:"isPlayer"`
+	Initiative         int    `json:"initiative"`
+	InitiativeModifier int    `json:"initiativeModifier"`
+	CurrentHealth      int    `json:"currentHealth"`
+	MaxHealth          int    `json:"maxHealth"`
+	ArmorClass         int    `json:"armorClass"`
+	LifeState          string `json:"lifeState"` // PCs only
+	Defeated           bool   `json:"defeated"`  // Dead PCs and NPCs at 0 HP skip their turns
+}
+
+// EncounterMonster describes NPCs joining an encounter. HitPoints is rolled
+// from HitDice for each one when not given. With a CreatureID, fields left
+// empty are filled from the creature's stat block; ArmorClass and
+// InitiativeModifier are pointers so that an explicit 0 overrides the creature.
+type EncounterMonster struct {
+	CreatureID         string `json:"creatureId"`
+	Name               string `json:"name"`
+	Count              int    `json:"count"` // 1 if not set
+	HitPoints          int    `json:"hitPoints"`
+	HitDice            string `json:"hitDice"` // e.g. "1d8+2"
+	ArmorClass         *int   `json:"armorClass"`
+	InitiativeModifier *int   `json:"initiativeModifier"`
+}
+
+// EncounterEvent is a line in an encounter's log
+type EncounterEvent struct {
+	Round     int       `json:"round"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
@@ -0,0 +1,13 @@
+package models
+
+// ExperienceStatus summarizes a character's progress toward their next level
+type ExperienceStatus struct {
+	CharacterID       string `json:"characterId"`
+	CharacterName     string `json:"characterName"`
+	Level             int    `json:"level"`
+	TotalExperience   int    `json:"totalExperience"`
+	CurrentExperience int    `json:"currentExperience"` // XP earned since reaching the current level
+	ExperienceNeeded  int    `json:"experienceNeeded"`  // XP span of the current level
+	NextLevelAt       int    `json:"nextLevelAt"`       // Total XP required for the next level
+	CanLevelUp        bool   `json:"canLevelUp"`
+}
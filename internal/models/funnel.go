@@ -0,0 +1,9 @@
+package models
+
+// FunnelOptions controls zero-level funnel character generation
+type FunnelOptions struct {
+	NamePrefix      string `json:"namePrefix"`      // Characters are named "<prefix> 1", "<prefix> 2", ...
+	PartyID         string `json:"partyId"`         // Optional party to add the characters to
+	Alignment       int    `json:"alignment"`       // 0=Neutral, 1=Lawful, 2=Chaotic
+	RandomAlignment bool   `json:"randomAlignment"` // Roll alignment for each character instead
+}
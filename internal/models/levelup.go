@@ -0,0 +1,12 @@
+package models
+
+// LevelUpResult describes the outcome of a level up
+type LevelUpResult struct {
+	Character       *Character `json:"character"`
+	ClassName       string     `json:"className"`
+	Level           int        `json:"level"`
+	HitDie          string     `json:"hitDie"`
+	HitPointRoll    int        `json:"hitPointRoll"`
+	HitPointsGained int        `json:"hitPointsGained"`
+	DeedDie         string     `json:"deedDie,omitempty"`
+}
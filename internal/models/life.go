@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// Life states. An empty state is treated as alive so older characters load unchanged.
+const (
+	LifeStateAlive = "alive"
+	LifeStateDying = "dying" // At 0 HP and bleeding out
+	LifeStateDead  = "dead"
+)
+
+// LifeStatus tracks whether a character is alive, bleeding out or dead
+type LifeStatus struct {
+	State           string    `json:"state"`
+	RoundsRemaining int       `json:"roundsRemaining"` // Rounds left to bleed out while dying
+	RoundsDead      int       `json:"roundsDead"`      // Game time since death, for recovering the body
+	BodyRecovered   bool      `json:"bodyRecovered"`   // The body has already been rolled over
+	Cause           string    `json:"cause"`
+	Session         string    `json:"session"`
+	DiedAt          time.Time `json:"diedAt"`
+}
+
+// BodyRecoveryResult is the outcome of rolling over a fallen character's body
+type BodyRecoveryResult struct {
+	Character *Character `json:"character"`
+	Roll      int        `json:"roll"`
+	Luck      int        `json:"luck"`
+	Success   bool       `json:"success"`
+	StatLoss  string     `json:"statLoss"` // Attribute that permanently lost a point
+}
+
+// GraveyardEntry describes a dead character
+type GraveyardEntry struct {
+	CharacterID   string    `json:"characterId"`
+	CharacterName string    `json:"characterName"`
+	Level         int       `json:"level"`
+	Class         string    `json:"class"`
+	Occupation    string    `json:"occupation"`
+	Cause         string    `json:"cause"`
+	Session       string    `json:"session"`
+	DiedAt        time.Time `json:"diedAt"`
+	BodyRecovered bool      `json:"bodyRecovered"`
+}
@@ -0,0 +1,43 @@
+package models
+
+// Loot split methods
+const (
+	LootSplitEven   = "even"   // Equal shares for every active member
+	LootSplitShares = "shares" // Weighted by LootStrategy.Shares
+	LootSplitLiving = "living" // Equal shares, skipping the dead and absent
+)
+
+// LootRemainderStash sends whatever can't be split evenly to the party stash
+const LootRemainderStash = "stash"
+
+// Loot is a haul of coins and items to divide among a party
+type Loot struct {
+	Coins Purse       `json:"coins"`
+	Items []Equipment `json:"items"`
+}
+
+// LootStrategy decides who gets a share of the loot and how big it is
+type LootStrategy struct {
+	Method      string         `json:"method"`
+	Shares      map[string]int `json:"shares"`      // Character ID to number of shares, for the shares method; missing members get 1
+	Absent      []string       `json:"absent"`      // Character IDs skipped by the living method
+	RemainderTo string         `json:"remainderTo"` // Character ID, or "stash" (the default)
+}
+
+// LootShare is what one recipient receives
+type LootShare struct {
+	CharacterID   string      `json:"characterId"`
+	CharacterName string      `json:"characterName"`
+	Shares        int         `json:"shares"`
+	Coins         Purse       `json:"coins"`
+	Items         []Equipment `json:"items"`
+}
+
+// LootSplitResult describes how loot was divided
+type LootSplitResult struct {
+	PartyID    string      `json:"partyId"`
+	Method     string      `json:"method"`
+	Recipients []LootShare `json:"recipients"`
+	Remainder  LootShare   `json:"remainder"` // CharacterID is empty when the remainder went to the stash
+	Skipped    []string    `json:"skipped"`   // Names of members who got nothing
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Luck event types
+const (
+	LuckEventBurn       = "burn"
+	LuckEventRegenerate = "regenerate"
+)
+
+// LuckEvent records a Luck burn or regeneration for auditing
+type LuckEvent struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Session    string    `json:"session"`
+	Type       string    `json:"type"`   // burn or regenerate
+	Amount     int       `json:"amount"` // Points of Luck burned or regained
+	Bonus      int       `json:"bonus"`  // Bonus gained from a burn
+	LuckBefore int       `json:"luckBefore"`
+	LuckAfter  int       `json:"luckAfter"`
+	Reason     string    `json:"reason"`
+}
+
+// LuckBurnResult represents the outcome of burning Luck
+type LuckBurnResult struct {
+	Character *Character `json:"character"`
+	Amount    int        `json:"amount"`
+	LuckDie   string     `json:"luckDie,omitempty"` // Thieves roll a luck die per point instead of +1, halflings get +2
+	Rolls     []int      `json:"rolls,omitempty"`
+	Bonus     int        `json:"bonus"`
+}
@@ -4,11 +4,103 @@ import "time"
 
 // Party represents a group of characters
 type Party struct {
-	ID           string    `json:"id"`
-	Name         string    `json:"name"`
-	Description  string    `json:"description"`
-	CharacterIDs []string  `json:"characterIds"`
-	IsActive     bool      `json:"isActive"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID            string         `json:"id"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	CharacterIDs  []string       `json:"characterIds"`
+	Members       []PartyMember  `json:"members"` // Kept in step with CharacterIDs
+	FormerMembers []FormerMember `json:"formerMembers"`
+	Stash         []Equipment    `json:"stash"` // Items held by the party rather than a character
+	StashPurse    Purse          `json:"stashPurse"`
+	History       []HistoryEntry `json:"history"`
+	IsActive      bool           `json:"isActive"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+}
+
+// Reasons a member leaves a party
+const (
+	PartyLeftDied    = "died"
+	PartyLeftRetired = "retired"
+	PartyLeftLeft    = "left"
+)
+
+// PartyMember is a character's place in a party
+type PartyMember struct {
+	CharacterID   string    `json:"characterId"`
+	CharacterName string    `json:"characterName"`
+	Role          string    `json:"role"`     // e.g. torchbearer, caller, mapper
+	Position      int       `json:"position"` // Place in the marching order, 1 at the front; 0 if not placed
+	JoinedAt      time.Time `json:"joinedAt"`
+}
+
+// FormerMember is a character who has left a party
+type FormerMember struct {
+	PartyMember
+	LeftAt time.Time `json:"leftAt"`
+	Reason string    `json:"reason"` // died, retired, left, or free text
+}
+
+// PartyExperienceOptions controls how AwardPartyExperience hands out XP
+type PartyExperienceOptions struct {
+	Split   bool     `json:"split"`   // Divide the amount among members instead of giving each the full amount
+	Absent  []string `json:"absent"`  // Character IDs who get nothing
+	Reason  string   `json:"reason"`  // e.g. "Cleared the sunken temple"
+	Session string   `json:"session"` // Session the award belongs to, today's by default
+}
+
+// PartyExperienceResult describes an XP award to a party
+type PartyExperienceResult struct {
+	PartyID    string             `json:"partyId"`
+	Session    string             `json:"session"`
+	AmountEach int                `json:"amountEach"`
+	Remainder  int                `json:"remainder"`  // XP left over from an uneven split
+	FunnelRule bool               `json:"funnelRule"` // Every recipient is level 0, so each got the full amount
+	Awarded    []ExperienceStatus `json:"awarded"`
+	Skipped    []string           `json:"skipped"` // Names of members who got nothing
+}
+
+// PartySummary aggregates a party's members for the party dashboard and exports
+type PartySummary struct {
+	PartyID           string              `json:"partyId"`
+	PartyName         string              `json:"partyName"`
+	MemberCount       int                 `json:"memberCount"`
+	LivingCount       int                 `json:"livingCount"`
+	CurrentHealth     int                 `json:"currentHealth"` // Living members only
+	MaxHealth         int                 `json:"maxHealth"`
+	AverageArmorClass float64             `json:"averageArmorClass"`
+	LowestArmorClass  int                 `json:"lowestArmorClass"`
+	TotalLevel        int                 `json:"totalLevel"`
+	AverageLevel      float64             `json:"averageLevel"`
+	TotalWealth       float64             `json:"totalWealth"` // In gold pieces, including the dead's belongings and the stash
+	StashWealth       float64             `json:"stashWealth"`
+	CarriedWeight     float64             `json:"carriedWeight"`
+	Wounded           []PartyMemberStatus `json:"wounded"`
+	Dying             []PartyMemberStatus `json:"dying"`
+	Dead              []PartyMemberStatus `json:"dead"`
+	BestSaves         BestSaves           `json:"bestSaves"`
+}
+
+// PartyMemberStatus is a member's health at a glance
+type PartyMemberStatus struct {
+	CharacterID     string `json:"characterId"`
+	CharacterName   string `json:"characterName"`
+	CurrentHealth   int    `json:"currentHealth"`
+	MaxHealth       int    `json:"maxHealth"`
+	LifeState       string `json:"lifeState"`
+	RoundsRemaining int    `json:"roundsRemaining"` // Rounds left before a dying member bleeds out
+}
+
+// BestSaves holds the living member with the best bonus for each save
+type BestSaves struct {
+	Reflex    SaveLeader `json:"reflex"`
+	Fortitude SaveLeader `json:"fortitude"`
+	Willpower SaveLeader `json:"willpower"`
+}
+
+// SaveLeader is the member with the best bonus for a save
+type SaveLeader struct {
+	CharacterID   string `json:"characterId"`
+	CharacterName string `json:"characterName"`
+	Bonus         int    `json:"bonus"`
 }
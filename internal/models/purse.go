@@ -0,0 +1,19 @@
+package models
+
+// Purse holds a character's coins by denomination
+type Purse struct {
+	PP int `json:"pp"`
+	EP int `json:"ep"`
+	GP int `json:"gp"`
+	SP int `json:"sp"`
+	CP int `json:"cp"`
+}
+
+// Wealth totals the value of a character's coins and equipment, in gold pieces
+type Wealth struct {
+	CharacterID string  `json:"characterId"`
+	Purse       Purse   `json:"purse"`
+	CoinValue   float64 `json:"coinValue"`
+	ItemValue   float64 `json:"itemValue"`
+	TotalValue  float64 `json:"totalValue"`
+}
@@ -0,0 +1,77 @@
+package models
+
+import "time"
+
+// Roll reasons used when logging rolls
+const (
+	RollReasonAttack     = "attack"
+	RollReasonDamage     = "damage"
+	RollReasonSave       = "save"
+	RollReasonSpellCheck = "spellcheck"
+	RollReasonTable      = "table"
+	RollReasonCrit       = "crit"
+	RollReasonFumble     = "fumble"
+	RollReasonInitiative = "initiative"
+	RollReasonHitPoints  = "hitpoints"
+	RollReasonSkill      = "skill"
+	RollReasonOther      = "other"
+)
+
+// RollDie represents a single die within a logged roll
+type RollDie struct {
+	Sides int `json:"sides"`
+	Value int `json:"value"`
+}
+
+// RollLogEntry represents a single roll made for a character
+type RollLogEntry struct {
+	ID          string    `json:"id"`
+	CharacterID string    `json:"characterId"`
+	Session     string    `json:"session"` // Session key, see SessionKey
+	Reason      string    `json:"reason"`  // attack, save, spellcheck, table, etc.
+	Label       string    `json:"label"`   // Free text, e.g. weapon or save name
+	Expression  string    `json:"expression"`
+	Dice        []RollDie `json:"dice"`
+	Modifier    int       `json:"modifier"`
+	Total       int       `json:"total"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+// RollLogFilter narrows the results of a roll log query. Zero values match everything.
+type RollLogFilter struct {
+	Reason  string    `json:"reason"`
+	Session string    `json:"session"`
+	Since   time.Time `json:"since"`
+	Until   time.Time `json:"until"`
+	Limit   int       `json:"limit"` // Most recent N entries
+}
+
+// RollStats summarizes a character's rolls
+type RollStats struct {
+	CharacterID       string  `json:"characterId"`
+	TotalRolls        int     `json:"totalRolls"`
+	D20Rolls          int     `json:"d20Rolls"`
+	NaturalTwenties   int     `json:"naturalTwenties"`
+	NaturalOnes       int     `json:"naturalOnes"`
+	NaturalTwentyRate float64 `json:"naturalTwentyRate"`
+	NaturalOneRate    float64 `json:"naturalOneRate"`
+}
+
+// SessionRecap collects everything that happened to characters during a session
+type SessionRecap struct {
+	Session string                `json:"session"`
+	Rolls   []RollLogEntry        `json:"rolls"`
+	History []SessionHistoryEntry `json:"history"`
+}
+
+// SessionHistoryEntry is a character history entry annotated with its character
+type SessionHistoryEntry struct {
+	CharacterID   string       `json:"characterId"`
+	CharacterName string       `json:"characterName"`
+	Entry         HistoryEntry `json:"entry"`
+}
+
+// SessionKey returns the session a timestamp belongs to. Sessions are keyed by local date.
+func SessionKey(t time.Time) string {
+	return t.Local().Format("2006-01-02")
+}
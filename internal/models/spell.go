@@ -0,0 +1,20 @@
+package models
+
+// SpellCastResult represents the outcome of a spell check
+type SpellCastResult struct {
+	CharacterID string `json:"characterId"`
+	AbilityID   string `json:"abilityId"`
+	SpellName   string `json:"spellName"`
+	Expression  string `json:"expression"`
+	Natural     int    `json:"natural"`
+	Modifier    int    `json:"modifier"`
+	Total       int    `json:"total"`
+	DC          int    `json:"dc"`
+	Success     bool   `json:"success"`
+	Lost        bool   `json:"lost"`
+	Misfire     bool   `json:"misfire"`
+	Corruption  bool   `json:"corruption"`
+	PatronTaint bool   `json:"patronTaint"`
+	Outcome     string `json:"outcome"`
+	TableResult string `json:"tableResult,omitempty"` // Matching entry from the spell's results table
+}
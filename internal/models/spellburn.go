@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// SpellburnState tracks outstanding spellburn and the ledger of burns
+type SpellburnState struct {
+	Strength int              `json:"strength"` // Outstanding points still to heal
+	Agility  int              `json:"agility"`
+	Stamina  int              `json:"stamina"`
+	Ledger   []SpellburnEntry `json:"ledger"`
+}
+
+// SpellburnEntry records a spellburn or a day of healing it
+type SpellburnEntry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Session   string    `json:"session"`
+	Strength  int       `json:"strength"` // Negative when burned, positive when healed
+	Agility   int       `json:"agility"`
+	Stamina   int       `json:"stamina"`
+	Reason    string    `json:"reason"`
+}
+
+// SpellburnAllocation is the number of points to burn from each attribute
+type SpellburnAllocation struct {
+	Strength int    `json:"strength"`
+	Agility  int    `json:"agility"`
+	Stamina  int    `json:"stamina"`
+	Reason   string `json:"reason"`
+}
+
+// SpellburnResult represents the outcome of a spellburn
+type SpellburnResult struct {
+	Character *Character `json:"character"`
+	Bonus     int        `json:"bonus"` // Added to the spell check
+}
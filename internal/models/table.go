@@ -0,0 +1,25 @@
+package models
+
+// TableRollResult represents the outcome of rolling on a custom table
+type TableRollResult struct {
+	CharacterID string     `json:"characterId"`
+	TableID     string     `json:"tableId"`
+	TableName   string     `json:"tableName"`
+	Expression  string     `json:"expression"`
+	Roll        int        `json:"roll"`
+	Modifier    int        `json:"modifier"`
+	Total       int        `json:"total"`   // Roll plus modifier, clamped to the table's range
+	Clamped     bool       `json:"clamped"` // True if the total fell outside the table
+	Entry       TableEntry `json:"entry"`
+}
+
+// CritResult represents the outcome of a roll on a crit or fumble table
+type CritResult struct {
+	CharacterID string `json:"characterId"`
+	Table       string `json:"table"`
+	Expression  string `json:"expression"`
+	Roll        int    `json:"roll"`     // Dice total before modifiers
+	Modifier    int    `json:"modifier"` // Luck modifier
+	Total       int    `json:"total"`
+	Result      string `json:"result"`
+}
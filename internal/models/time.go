@@ -0,0 +1,44 @@
+package models
+
+// Game time units
+const (
+	TimeUnitRounds = "rounds"
+	TimeUnitTurns  = "turns"
+	TimeUnitHours  = "hours"
+	TimeUnitDays   = "days"
+)
+
+// Rest qualities
+const (
+	RestNone    = "none"    // Time passes without rest, e.g. travel or dungeon crawling
+	RestNormal  = "rest"    // A night's rest
+	RestBedRest = "bedrest" // A full day of bed rest
+)
+
+// GameDuration is an amount of game time, e.g. 3 turns or 2 days
+type GameDuration struct {
+	Value int    `json:"value"`
+	Unit  string `json:"unit"` // rounds, turns, hours or days
+}
+
+// RestResult summarizes what time passing did for one character
+type RestResult struct {
+	CharacterID       string   `json:"characterId"`
+	CharacterName     string   `json:"characterName"`
+	HitPointsHealed   int      `json:"hitPointsHealed"`
+	SpellburnHealed   int      `json:"spellburnHealed"`
+	AttributesHealed  int      `json:"attributesHealed"`
+	SpellsRestored    int      `json:"spellsRestored"`
+	LuckRegained      int      `json:"luckRegained"`
+	ConditionsExpired []string `json:"conditionsExpired"`
+	BledOut           bool     `json:"bledOut"`
+	FeaturesReset     []string `json:"featuresReset"` // Per-day class features restored by rest
+}
+
+// TimeAdvanceResult summarizes a time advance for a party
+type TimeAdvanceResult struct {
+	PartyID     string       `json:"partyId"`
+	Duration    GameDuration `json:"duration"`
+	RestQuality string       `json:"restQuality"`
+	Characters  []RestResult `json:"characters"`
+}
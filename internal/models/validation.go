@@ -0,0 +1,32 @@
+package models
+
+// Validation severities
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+)
+
+// ValidationIssue is a problem with one field of a character
+type ValidationIssue struct {
+	Field    string `json:"field"` // e.g. "currentHealth" or "equipment[2].damageDice"
+	Code     string `json:"code"`  // Check that failed, e.g. "hp-above-max"
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// ValidationResult lists a character's errors and warnings. A character with
+// errors breaks the rules; warnings are unusual but allowed.
+type ValidationResult struct {
+	CharacterID string            `json:"characterId"`
+	Valid       bool              `json:"valid"`
+	Errors      []ValidationIssue `json:"errors"`
+	Warnings    []ValidationIssue `json:"warnings"`
+}
+
+// HouseRules relaxes specific validation checks for a table's house rules
+type HouseRules struct {
+	StrictSaves       bool     `json:"strictSaves"`       // Reject saving characters with errors
+	MaxAttributeScore int      `json:"maxAttributeScore"` // Highest legal attribute score, 0 for the default
+	MaxLevel          int      `json:"maxLevel"`          // Highest legal level, 0 for the default
+	Relaxed           []string `json:"relaxed"`           // Check codes reported as warnings instead of errors
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+const unarmoredFumbleDie = "1d4"
+
+// equippedArmor returns a character's active, equipped armor with blank
+// fumble dice and check penalties filled in from the catalog by name. A
+// check penalty set by hand (CheckPenaltyOverride) is kept, even at 0.
+func equippedArmor(cat *catalog.Catalog, character *models.Character) []models.Equipment {
+	var armor []models.Equipment
+	for _, item := range character.Equipment {
+		if !item.IsActive || !item.Equipped || item.Category != "armor" {
+			continue
+		}
+
+		if entry, err := cat.GetArmor(item.Name); err == nil {
+			if item.FumbleDie == "" {
+				item.FumbleDie = entry.FumbleDie
+			}
+			if item.CheckPenalty == 0 && !item.CheckPenaltyOverride {
+				item.CheckPenalty = entry.CheckPenalty
+			}
+		}
+
+		armor = append(armor, item)
+	}
+	return armor
+}
+
+// ArmorCheckPenalty returns the total check penalty of a character's equipped armor
+func ArmorCheckPenalty(cat *catalog.Catalog, character *models.Character) int {
+	penalty := 0
+	for _, item := range equippedArmor(cat, character) {
+		penalty += item.CheckPenalty
+	}
+	return penalty
+}
+
+// FumbleDie returns the largest fumble die among a character's equipped armor
+func FumbleDie(cat *catalog.Catalog, character *models.Character) string {
+	best := unarmoredFumbleDie
+	bestMax := 4
+
+	for _, item := range equippedArmor(cat, character) {
+		parsed, err := dice.Parse(item.FumbleDie)
+		if err != nil {
+			continue
+		}
+
+		if parsed.Max() > bestMax {
+			best = parsed.String()
+			bestMax = parsed.Max()
+		}
+	}
+
+	return best
+}
+
+// ArmorSpeedPenalty returns the total speed penalty of a character's equipped
+// armor, looked up in the catalog by name
+func ArmorSpeedPenalty(cat *catalog.Catalog, character *models.Character) int {
+	penalty := 0
+	for _, item := range equippedArmor(cat, character) {
+		if entry, err := cat.GetArmor(item.Name); err == nil {
+			penalty += entry.SpeedPenalty
+		}
+	}
+	return penalty
+}
@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// BirthAugurKey returns the rules key of a character's birth augur, or an
+// empty string if the augur is not in the catalog
+func BirthAugurKey(cat *catalog.Catalog, character *models.Character) string {
+	if character.BirthAugur == "" {
+		return ""
+	}
+
+	// Augurs are stored as "<name>: <affects>" by the funnel generator
+	augur := strings.ToLower(strings.TrimSpace(character.BirthAugur))
+	for _, sign := range cat.LuckSigns {
+		name := strings.ToLower(sign.Name)
+		if augur == name || strings.HasPrefix(augur, name+":") {
+			return sign.Key
+		}
+	}
+
+	return ""
+}
+
+// BirthAugurModifier returns the Luck modifier if the character's birth augur
+// applies to the given rules key, or 0 otherwise
+func BirthAugurModifier(cat *catalog.Catalog, character *models.Character, key string) int {
+	if BirthAugurKey(cat, character) != key {
+		return 0
+	}
+	return AttributeModifier(character.Luck)
+}
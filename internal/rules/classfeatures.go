@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// ClassModule provides the special mechanics of a class. Modules describe
+// their state for a character and reset any per-day state after a night's
+// rest. Resolution (deeds, skill checks, turning) lives in plain functions.
+type ClassModule interface {
+	// Class is the name of the class the module applies to
+	Class() string
+	// Describe fills in the module's part of a character's class features
+	Describe(cat *catalog.Catalog, character *models.Character, features *models.ClassFeatures)
+	// Rest resets per-day state, returning a description of what was reset or ""
+	Rest(character *models.Character) string
+}
+
+var classModules = map[string]ClassModule{}
+
+func init() {
+	RegisterClassModule(deedModule{class: "Warrior"})
+	RegisterClassModule(deedModule{class: "Dwarf"})
+	RegisterClassModule(thiefModule{})
+	RegisterClassModule(clericModule{})
+}
+
+// RegisterClassModule adds or replaces the module for a class
+func RegisterClassModule(module ClassModule) {
+	classModules[strings.ToLower(module.Class())] = module
+}
+
+// characterModules returns the modules for a character's classes, ordered by class name
+func characterModules(character *models.Character) []ClassModule {
+	var modules []ClassModule
+	for _, module := range classModules {
+		if HasClass(character, module.Class()) {
+			modules = append(modules, module)
+		}
+	}
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Class() < modules[j].Class()
+	})
+	return modules
+}
+
+// ClassFeatures describes every class feature module that applies to a character
+func ClassFeatures(cat *catalog.Catalog, character *models.Character) *models.ClassFeatures {
+	features := &models.ClassFeatures{
+		CharacterID: character.ID,
+		Modules:     []string{},
+		ThreatRange: 20,
+		ThiefSkills: []models.ThiefSkillBonus{},
+	}
+
+	for _, module := range characterModules(character) {
+		features.Modules = append(features.Modules, module.Class())
+		module.Describe(cat, character, features)
+	}
+
+	return features
+}
+
+// RestClassFeatures resets the per-day state of a character's class modules
+func RestClassFeatures(character *models.Character) []string {
+	reset := []string{}
+	for _, module := range characterModules(character) {
+		if what := module.Rest(character); what != "" {
+			reset = append(reset, what)
+		}
+	}
+	return reset
+}
+
+// classProgression returns a character's advancement row in a class, or nil
+// if the catalog has no row for their level
+func classProgression(cat *catalog.Catalog, character *models.Character, class string) *catalog.LevelProgression {
+	if cat == nil {
+		return nil
+	}
+	progression, err := cat.GetClass(class)
+	if err != nil {
+		return nil
+	}
+	row, err := progression.Level(ClassLevel(character, class))
+	if err != nil {
+		return nil
+	}
+	return row
+}
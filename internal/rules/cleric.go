@@ -0,0 +1,205 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// clericModule tracks a cleric's disapproval range, which resets each day
+type clericModule struct{}
+
+func (clericModule) Class() string {
+	return "Cleric"
+}
+
+func (clericModule) Describe(cat *catalog.Catalog, character *models.Character, features *models.ClassFeatures) {
+	features.Disapproval = DisapprovalRange(character)
+}
+
+func (clericModule) Rest(character *models.Character) string {
+	if DisapprovalRange(character) == 1 {
+		return ""
+	}
+	character.ClassState.Disapproval = 0
+	return "disapproval range reset"
+}
+
+// DisapprovalRange returns the highest natural roll that brings a cleric's deity's disapproval
+func DisapprovalRange(character *models.Character) int {
+	if character.ClassState.Disapproval < 1 {
+		return 1
+	}
+	return character.ClassState.Disapproval
+}
+
+// clericCheck rolls a cleric's check with the action die and applies
+// disapproval: a natural roll within the range fails, and every failure
+// widens the range by one
+func clericCheck(roller *dice.Roller, character *models.Character, power string, modifier int, success func(total int) bool) (*models.ClericCheckResult, *dice.Result) {
+	roll := roller.RollExpression(&dice.Expression{
+		Terms:    []dice.Term{{Count: 1, Sides: ActionDie(character)}},
+		Modifier: modifier,
+	})
+
+	result := &models.ClericCheckResult{
+		CharacterID: character.ID,
+		Power:       power,
+		Expression:  roll.Expression,
+		Natural:     roll.Natural(),
+		Modifier:    modifier,
+		Total:       roll.Total,
+	}
+
+	result.Disapproval = result.Natural <= DisapprovalRange(character)
+	result.Success = !result.Disapproval && success(result.Total)
+	if !result.Success {
+		character.ClassState.Disapproval = DisapprovalRange(character) + 1
+	}
+	result.DisapprovalRange = DisapprovalRange(character)
+
+	switch {
+	case result.Disapproval:
+		result.Outcome = fmt.Sprintf("Natural %d: failure, deity disapproval", result.Natural)
+	case !result.Success:
+		result.Outcome = "Failure, disapproval range increases"
+	}
+
+	return result, roll
+}
+
+// clericModifier is the bonus to a cleric's checks: Personality modifier and cleric level
+func clericModifier(character *models.Character) int {
+	return AttributeModifier(character.Personality) + ClassLevel(character, "Cleric")
+}
+
+// LayOnHands heals a target. The check result and how closely the target's
+// alignment matches the cleric's decide how many of the target's hit dice are
+// healed; no more dice than the target has hit dice.
+func LayOnHands(roller *dice.Roller, cat *catalog.Catalog, cleric *models.Character, target *models.Character) (*models.ClericCheckResult, *dice.Result, error) {
+	if !HasClass(cleric, "Cleric") {
+		return nil, nil, fmt.Errorf("%s is not a cleric", cleric.Name)
+	}
+	if !IsAlive(target) && LifeState(target) != models.LifeStateDying {
+		return nil, nil, fmt.Errorf("%s is dead", target.Name)
+	}
+
+	result, roll := clericCheck(roller, cleric, "Lay on hands", clericModifier(cleric), func(total int) bool {
+		return total >= 12
+	})
+	result.TargetID = target.ID
+	if !result.Success {
+		return result, roll, nil
+	}
+
+	// Dice healed by check result for same, adjacent and opposed alignments
+	var byAlignment [3]int
+	switch {
+	case result.Total >= 22:
+		byAlignment = [3]int{5, 4, 3}
+	case result.Total >= 20:
+		byAlignment = [3]int{4, 3, 2}
+	case result.Total >= 14:
+		byAlignment = [3]int{3, 2, 1}
+	default:
+		byAlignment = [3]int{2, 1, 1}
+	}
+
+	count := byAlignment[alignmentDistance(cleric.Alignment, target.Alignment)]
+	hitDice := target.Level
+	if hitDice < 1 {
+		hitDice = 1
+	}
+	if count > hitDice {
+		count = hitDice
+	}
+
+	sides := targetHitDie(cat, target)
+	result.HealingDice = fmt.Sprintf("%dd%d", count, sides)
+	healing := roller.RollExpression(&dice.Expression{Terms: []dice.Term{{Count: count, Sides: sides}}})
+
+	if LifeState(target) == models.LifeStateDying {
+		if err := Stabilize(target, healing.Total); err != nil {
+			return nil, nil, err
+		}
+		result.Healed = target.CurrentHealth
+	} else {
+		before := target.CurrentHealth
+		target.CurrentHealth += healing.Total
+		if target.CurrentHealth > target.MaxHealth {
+			target.CurrentHealth = target.MaxHealth
+		}
+		result.Healed = target.CurrentHealth - before
+	}
+
+	result.Outcome = fmt.Sprintf("Healed %d HP (%s)", result.Healed, result.HealingDice)
+	return result, roll, nil
+}
+
+// TurnUnholy rolls a turn unholy check, adding Luck to the usual cleric
+// bonus. Higher results turn stronger unholy creatures.
+func TurnUnholy(roller *dice.Roller, character *models.Character) (*models.ClericCheckResult, *dice.Result, error) {
+	if !HasClass(character, "Cleric") {
+		return nil, nil, fmt.Errorf("%s is not a cleric", character.Name)
+	}
+
+	modifier := clericModifier(character) + AttributeModifier(character.Luck)
+	result, roll := clericCheck(roller, character, "Turn unholy", modifier, func(total int) bool {
+		return total >= 12
+	})
+	if !result.Success {
+		return result, roll, nil
+	}
+
+	switch {
+	case result.Total >= 30:
+		result.MaxHitDice = 12
+	case result.Total >= 28:
+		result.MaxHitDice = 10
+	case result.Total >= 24:
+		result.MaxHitDice = 8
+	case result.Total >= 20:
+		result.MaxHitDice = 6
+	case result.Total >= 18:
+		result.MaxHitDice = 4
+	case result.Total >= 14:
+		result.MaxHitDice = 3
+	default:
+		result.MaxHitDice = 2
+	}
+
+	result.Outcome = fmt.Sprintf("Turns unholy creatures of up to %d HD", result.MaxHitDice)
+	return result, roll, nil
+}
+
+// alignmentDistance returns 0 for the same alignment, 1 for adjacent
+// (one side neutral) and 2 for opposed (lawful and chaotic)
+func alignmentDistance(a, b int) int {
+	switch {
+	case a == b:
+		return 0
+	case a == 0 || b == 0:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// targetHitDie returns the sides of a character's class hit die, d4 for 0-level characters
+func targetHitDie(cat *catalog.Catalog, character *models.Character) int {
+	if character.Level < 1 || cat == nil {
+		return 4
+	}
+
+	progression, err := cat.GetClass(character.Class)
+	if err != nil {
+		return 4
+	}
+	hitDie, err := dice.Parse(progression.HitDie)
+	if err != nil || len(hitDie.Terms) == 0 {
+		return 4
+	}
+	return hitDie.Terms[0].Sides
+}
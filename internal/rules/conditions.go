@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// ApplyCondition adds a condition to a character, filling in its ID and
+// counting its duration down in rounds
+func ApplyCondition(character *models.Character, condition models.Condition) (*models.Condition, error) {
+	condition.Name = strings.TrimSpace(condition.Name)
+	if condition.Name == "" {
+		return nil, fmt.Errorf("condition needs a name")
+	}
+	if condition.Duration.Value < 0 {
+		return nil, fmt.Errorf("condition duration cannot be negative")
+	}
+
+	condition.RoundsRemaining = 0
+	if condition.Duration.Value > 0 {
+		rounds, err := DurationInRounds(condition.Duration)
+		if err != nil {
+			return nil, err
+		}
+		condition.RoundsRemaining = rounds
+	}
+
+	if condition.ID == "" {
+		condition.ID = fmt.Sprintf("condition-%d", time.Now().UnixNano())
+	}
+	condition.AppliedAt = time.Now()
+
+	character.Conditions = append(character.Conditions, condition)
+	return &character.Conditions[len(character.Conditions)-1], nil
+}
+
+// RemoveCondition ends a condition early
+func RemoveCondition(character *models.Character, conditionId string) (*models.Condition, error) {
+	for i, condition := range character.Conditions {
+		if condition.ID == conditionId {
+			character.Conditions = append(character.Conditions[:i], character.Conditions[i+1:]...)
+			return &condition, nil
+		}
+	}
+	return nil, fmt.Errorf("condition not found: %s", conditionId)
+}
+
+// TickConditions counts timed conditions down by a number of rounds and
+// removes the ones that wear off. Returns the names of expired conditions.
+func TickConditions(character *models.Character, rounds int) []string {
+	expired := []string{}
+	if rounds <= 0 {
+		return expired
+	}
+
+	remaining := character.Conditions[:0]
+	for _, condition := range character.Conditions {
+		if condition.Duration.Value == 0 {
+			remaining = append(remaining, condition)
+			continue
+		}
+
+		condition.RoundsRemaining -= rounds
+		if condition.RoundsRemaining <= 0 {
+			expired = append(expired, condition.Name)
+			continue
+		}
+		remaining = append(remaining, condition)
+	}
+	character.Conditions = remaining
+
+	return expired
+}
+
+// ConditionModifiers totals the attribute, save and AC modifiers of a character's conditions
+func ConditionModifiers(character *models.Character) (models.AttributeModifiers, models.Saves, int) {
+	var attrs models.AttributeModifiers
+	var saves models.Saves
+	ac := 0
+
+	for _, condition := range character.Conditions {
+		attrs.Strength += condition.Attributes.Strength
+		attrs.Agility += condition.Attributes.Agility
+		attrs.Stamina += condition.Attributes.Stamina
+		attrs.Personality += condition.Attributes.Personality
+		attrs.Intelligence += condition.Attributes.Intelligence
+		attrs.Luck += condition.Attributes.Luck
+		saves.Reflex += condition.Saves.Reflex
+		saves.Fortitude += condition.Saves.Fortitude
+		saves.Willpower += condition.Saves.Willpower
+		ac += condition.ArmorClass
+	}
+
+	return attrs, saves, ac
+}
@@ -0,0 +1,270 @@
+package rules
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// ParseStatBlock reads a DCC stat block as printed in the modules, e.g.
+//
+//	Goblin (3): Init -1; Atk bite -1 melee (1d3); AC 10; HD 1d6-1; hp 3 each;
+//	MV 20'; Act 1d20; SP infravision 60'; SV Fort -2, Ref +1, Will -2; AL L.
+//
+// Fields it does not recognize are kept in the description.
+func ParseStatBlock(text string) (*models.Creature, error) {
+	text = strings.Join(strings.Fields(text), " ")
+	colon := strings.Index(text, ":")
+	if colon < 0 {
+		return nil, fmt.Errorf("stat block must start with a name followed by ':'")
+	}
+
+	creature := &models.Creature{
+		Name:         statBlockName(text[:colon]),
+		Attacks:      []models.CreatureAttack{},
+		ActionDice:   "1d20",
+		WorldNoteIDs: []string{},
+		StatBlock:    text,
+	}
+	if creature.Name == "" {
+		return nil, fmt.Errorf("stat block has no name")
+	}
+
+	var unknown []string
+	recognized := 0
+	for _, field := range splitTopLevel(strings.TrimSuffix(text[colon+1:], "."), ";") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		key, value := statBlockField(field)
+		if value == "" {
+			continue
+		}
+
+		var err error
+		switch key {
+		case "init":
+			creature.Initiative, err = leadingInt(value)
+		case "atk":
+			creature.Attacks, err = parseAttacks(value)
+		case "ac":
+			creature.ArmorClass, err = leadingInt(value)
+		case "hd":
+			creature.HitDice = strings.Fields(value)[0]
+			_, err = dice.Parse(creature.HitDice)
+		case "hp":
+			creature.HitPoints, err = leadingInt(value)
+		case "mv":
+			creature.Movement = value
+			creature.Speed, _ = leadingInt(value)
+		case "act":
+			creature.ActionDice = value
+			_, err = dice.ParseActionDice(value)
+		case "sp":
+			creature.SpecialProperties = value
+		case "sv":
+			creature.Saves, err = parseSaves(value)
+		case "al":
+			creature.Alignment, err = parseAlignment(value)
+			// Text after the alignment, such as a closing description
+			if words := strings.SplitN(value, " ", 2); len(words) == 2 {
+				unknown = append(unknown, strings.TrimSpace(words[1]))
+			}
+		default:
+			unknown = append(unknown, field)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", strings.ToUpper(key), err)
+		}
+		recognized++
+	}
+
+	if recognized == 0 {
+		return nil, fmt.Errorf("no stat block fields found (Init, Atk, AC, HD, MV, Act, SP, SV, AL)")
+	}
+	creature.Description = strings.Join(unknown, "; ")
+
+	return creature, nil
+}
+
+// CreatureMonster fills the empty fields of an encounter monster from a
+// creature. Armor class and initiative are only filled in when not given, so
+// an explicit 0 overrides the stat block.
+func CreatureMonster(monster models.EncounterMonster, creature *models.Creature) models.EncounterMonster {
+	monster.CreatureID = creature.ID
+	if monster.Name == "" {
+		monster.Name = creature.Name
+	}
+	if monster.HitPoints == 0 && monster.HitDice == "" {
+		monster.HitPoints = creature.HitPoints
+		monster.HitDice = creature.HitDice
+	}
+	if monster.ArmorClass == nil {
+		armorClass := creature.ArmorClass
+		monster.ArmorClass = &armorClass
+	}
+	if monster.InitiativeModifier == nil {
+		initiative := creature.Initiative
+		monster.InitiativeModifier = &initiative
+	}
+	return monster
+}
+
+// statBlockName strips a count or hit dice note from a name, e.g. "Goblins (3)"
+func statBlockName(name string) string {
+	if open := strings.Index(name, "("); open >= 0 {
+		name = name[:open]
+	}
+	return strings.TrimSpace(name)
+}
+
+// statBlockField splits a field into its lower-case key and value
+func statBlockField(field string) (string, string) {
+	parts := strings.SplitN(field, " ", 2)
+	key := strings.ToLower(parts[0])
+	switch key {
+	case "init", "atk", "ac", "hd", "hp", "mv", "act", "sp", "sv", "al":
+		if len(parts) < 2 {
+			return key, ""
+		}
+		return key, strings.TrimSpace(parts[1])
+	}
+	return "unknown", field
+}
+
+// leadingInt reads the signed number at the start of a value, e.g. "+2" or "13 (chain mail)"
+func leadingInt(value string) (int, error) {
+	value = strings.TrimSpace(value)
+	end := 0
+	for end < len(value) && (value[end] >= '0' && value[end] <= '9' || end == 0 && (value[end] == '+' || value[end] == '-')) {
+		end++
+	}
+	number, err := strconv.Atoi(value[:end])
+	if err != nil {
+		return 0, fmt.Errorf("expected a number, got '%s'", value)
+	}
+	return number, nil
+}
+
+// parseAttacks reads attacks such as "claw +2 melee (1d4) or spear +1 missile fire (1d6)"
+func parseAttacks(value string) ([]models.CreatureAttack, error) {
+	attacks := []models.CreatureAttack{}
+	for _, part := range splitTopLevel(value, ",", " or ", " and ") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		attack := models.CreatureAttack{}
+		if open := strings.Index(part, "("); open >= 0 {
+			attack.Damage = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(part[open+1:]), ")"))
+			part = strings.TrimSpace(part[:open])
+		}
+
+		var name []string
+		found := false
+		for _, word := range strings.Fields(part) {
+			if !found && (strings.HasPrefix(word, "+") || strings.HasPrefix(word, "-")) {
+				bonus, err := leadingInt(word)
+				if err != nil {
+					return nil, err
+				}
+				attack.Bonus = bonus
+				found = true
+				continue
+			}
+			if found {
+				if attack.Type == "" && (word == "melee" || word == "missile" || word == "ranged") {
+					attack.Type = word
+				}
+				continue
+			}
+			name = append(name, word)
+		}
+		if !found {
+			return nil, fmt.Errorf("attack '%s' has no bonus", part)
+		}
+
+		attack.Name = strings.Join(name, " ")
+		attacks = append(attacks, attack)
+	}
+	return attacks, nil
+}
+
+// parseSaves reads saves written as "Fort +2, Ref +1, Will +0"
+func parseSaves(value string) (models.Saves, error) {
+	var saves models.Saves
+	for _, part := range strings.Split(value, ",") {
+		fields := strings.Fields(part)
+		if len(fields) < 2 {
+			return saves, fmt.Errorf("expected a save and a bonus, got '%s'", strings.TrimSpace(part))
+		}
+
+		bonus, err := leadingInt(fields[1])
+		if err != nil {
+			return saves, err
+		}
+
+		switch strings.ToLower(strings.TrimSuffix(fields[0], ".")) {
+		case "fort", "fortitude":
+			saves.Fortitude = bonus
+		case "ref", "reflex":
+			saves.Reflex = bonus
+		case "will", "willpower":
+			saves.Willpower = bonus
+		default:
+			return saves, fmt.Errorf("unknown save '%s'", fields[0])
+		}
+	}
+	return saves, nil
+}
+
+// parseAlignment reads L, N or C (or the full word) as a character alignment
+func parseAlignment(value string) (int, error) {
+	switch strings.ToUpper(value[:1]) {
+	case "N":
+		return 0, nil
+	case "L":
+		return 1, nil
+	case "C":
+		return 2, nil
+	}
+	return 0, fmt.Errorf("unknown alignment '%s'", value)
+}
+
+// splitTopLevel splits text on any of the separators outside parentheses
+func splitTopLevel(text string, separators ...string) []string {
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '(':
+			depth++
+			continue
+		case ')':
+			if depth > 0 {
+				depth--
+			}
+			continue
+		}
+		if depth > 0 {
+			continue
+		}
+		for _, separator := range separators {
+			if strings.HasPrefix(text[i:], separator) {
+				parts = append(parts, text[start:i])
+				start = i + len(separator)
+				i = start - 1
+				break
+			}
+		}
+	}
+	return append(parts, text[start:])
+}
@@ -0,0 +1,163 @@
+package rules
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+func TestParseStatBlock(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		want  models.Creature
+	}{
+		{
+			name: "doc comment sample",
+			block: `Goblin (3): Init -1; Atk bite -1 melee (1d3); AC 10; HD 1d6-1; hp 3 each;
+MV 20'; Act 1d20; SP infravision 60'; SV Fort -2, Ref +1, Will -2; AL L.`,
+			want: models.Creature{
+				Name:       "Goblin",
+				Initiative: -1,
+				Attacks: []models.CreatureAttack{
+					{Name: "bite", Bonus: -1, Type: "melee", Damage: "1d3"},
+				},
+				ArmorClass:        10,
+				HitDice:           "1d6-1",
+				HitPoints:         3,
+				Movement:          "20'",
+				Speed:             20,
+				ActionDice:        "1d20",
+				SpecialProperties: "infravision 60'",
+				Saves:             models.Saves{Fortitude: -2, Reflex: 1, Willpower: -2},
+				Alignment:         1,
+			},
+		},
+		{
+			name:  "multi-attack with dmg and two action dice",
+			block: `Ogre: Init +1; Atk club +6 melee (2d6+4 dmg) or fist +4 melee (1d6+4 dmg); AC 15; HD 4d8+8; hp 28; MV 30'; Act 2d20; SP infravision 60'; SV Fort +5, Ref +0, Will +1; AL C.`,
+			want: models.Creature{
+				Name:       "Ogre",
+				Initiative: 1,
+				Attacks: []models.CreatureAttack{
+					{Name: "club", Bonus: 6, Type: "melee", Damage: "2d6+4 dmg"},
+					{Name: "fist", Bonus: 4, Type: "melee", Damage: "1d6+4 dmg"},
+				},
+				ArmorClass:        15,
+				HitDice:           "4d8+8",
+				HitPoints:         28,
+				Movement:          "30'",
+				Speed:             30,
+				ActionDice:        "2d20",
+				SpecialProperties: "infravision 60'",
+				Saves:             models.Saves{Fortitude: 5, Reflex: 0, Willpower: 1},
+				Alignment:         2,
+			},
+		},
+		{
+			name:  "flying movement and a missile attack",
+			block: `Harpy (1d4): Init +3; Atk claw +2 melee (1d4 dmg, DC 10 Fort save or poisoned) or rock +1 missile fire (1d6 dmg); AC 13; HD 2d8; MV 30' or fly 40'; Act 1d20; SP song (DC 12 Will save or charmed); SV Fort +1, Ref +3, Will +2; AL C.`,
+			want: models.Creature{
+				Name:       "Harpy",
+				Initiative: 3,
+				Attacks: []models.CreatureAttack{
+					{Name: "claw", Bonus: 2, Type: "melee", Damage: "1d4 dmg, DC 10 Fort save or poisoned"},
+					{Name: "rock", Bonus: 1, Type: "missile", Damage: "1d6 dmg"},
+				},
+				ArmorClass:        13,
+				HitDice:           "2d8",
+				Movement:          "30' or fly 40'",
+				Speed:             30,
+				ActionDice:        "1d20",
+				SpecialProperties: "song (DC 12 Will save or charmed)",
+				Saves:             models.Saves{Fortitude: 1, Reflex: 3, Willpower: 2},
+				Alignment:         2,
+			},
+		},
+		{
+			name:  "attack without damage, armor note and trailing description",
+			block: `Skeleton: Init +0; Atk claw +0 melee (1d3) or by weapon +0 melee; AC 9 (rusted chain); HD 1d6; MV 30'; Act 1d20; SP un-dead, half damage from piercing weapons; SV Fort +0, Ref +0, Will +0; AL C. They rise from the ossuary floor.`,
+			want: models.Creature{
+				Name:       "Skeleton",
+				Initiative: 0,
+				Attacks: []models.CreatureAttack{
+					{Name: "claw", Bonus: 0, Type: "melee", Damage: "1d3"},
+					{Name: "by weapon", Bonus: 0, Type: "melee"},
+				},
+				ArmorClass:        9,
+				HitDice:           "1d6",
+				Movement:          "30'",
+				Speed:             30,
+				ActionDice:        "1d20",
+				SpecialProperties: "un-dead, half damage from piercing weapons",
+				Alignment:         2,
+				Description:       "They rise from the ossuary floor",
+			},
+		},
+		{
+			name:  "empty fields are skipped",
+			block: `Giant rat: Init +4;; Atk bite +2 melee (1d4+2 plus disease); AC 13; ; HD 1d6+2; MV 30' or climb 20'; SV Fort +4, Ref +2, Will -1; AL N; `,
+			want: models.Creature{
+				Name:       "Giant rat",
+				Initiative: 4,
+				Attacks: []models.CreatureAttack{
+					{Name: "bite", Bonus: 2, Type: "melee", Damage: "1d4+2 plus disease"},
+				},
+				ArmorClass: 13,
+				HitDice:    "1d6+2",
+				Movement:   "30' or climb 20'",
+				Speed:      30,
+				ActionDice: "1d20",
+				Saves:      models.Saves{Fortitude: 4, Reflex: 2, Willpower: -1},
+				Alignment:  0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStatBlock(tt.block)
+			if err != nil {
+				t.Fatalf("ParseStatBlock returned error: %v", err)
+			}
+
+			if got.StatBlock != strings.Join(strings.Fields(tt.block), " ") {
+				t.Errorf("StatBlock = %q, want the original text", got.StatBlock)
+			}
+			if len(got.WorldNoteIDs) != 0 {
+				t.Errorf("WorldNoteIDs = %v, want none", got.WorldNoteIDs)
+			}
+
+			got.StatBlock = ""
+			got.WorldNoteIDs = nil
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("ParseStatBlock =\n%+v\nwant\n%+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatBlockRejects(t *testing.T) {
+	tests := []string{
+		"",
+		"Goblin Init -1; AC 10",
+		": Init -1; AC 10",
+		"Goblin: a small and nasty thing",
+		"Goblin: AC ten",
+		"Goblin: Init fast",
+		"Goblin: Atk bite melee (1d3)",
+		"Goblin: HD lots",
+		"Goblin: HD 1000d6",
+		"Goblin: Act 1d20+1",
+		"Goblin: SV Luck +2",
+		"Goblin: AL X",
+	}
+
+	for _, block := range tests {
+		if got, err := ParseStatBlock(block); err == nil {
+			t.Errorf("ParseStatBlock(%q) = %+v, want error", block, got)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+const (
+	defaultCritDice  = "1d4"
+	defaultCritTable = "I"
+)
+
+// RollCrit rolls a character's crit die plus their Luck modifier on their crit table
+func RollCrit(roller *dice.Roller, cat *catalog.Catalog, character *models.Character) (*models.CritResult, *dice.Result, error) {
+	critDice := strings.TrimSpace(character.CritDice)
+	if critDice == "" {
+		critDice = defaultCritDice
+	}
+
+	tableName := strings.TrimSpace(character.CritTable)
+	if tableName == "" {
+		tableName = defaultCritTable
+	}
+
+	table, err := cat.GetCritTable(tableName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rollOnTable(roller, character, table, critDice, AttributeModifier(character.Luck))
+}
+
+// RollFumble rolls the fumble die from a character's equipped armor, adjusted by Luck, on the fumble table
+func RollFumble(roller *dice.Roller, cat *catalog.Catalog, character *models.Character) (*models.CritResult, *dice.Result, error) {
+	table, err := cat.GetFumbleTable()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// A good Luck modifier lowers the fumble roll
+	return rollOnTable(roller, character, table, FumbleDie(cat, character), -AttributeModifier(character.Luck))
+}
+
+func rollOnTable(roller *dice.Roller, character *models.Character, table *catalog.ResultTable, expr string, modifier int) (*models.CritResult, *dice.Result, error) {
+	roll, err := roller.Roll(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	total := roll.Total + modifier
+	entry, err := table.Lookup(total)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &models.CritResult{
+		CharacterID: character.ID,
+		Table:       table.Name,
+		Expression:  roll.Expression,
+		Roll:        roll.Total,
+		Modifier:    modifier,
+		Total:       total,
+		Result:      entry.Result,
+	}, roll, nil
+}
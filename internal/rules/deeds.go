@@ -0,0 +1,82 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// deedModule gives warriors and dwarves their deed die and improved threat range
+type deedModule struct {
+	class string
+}
+
+func (m deedModule) Class() string {
+	return m.class
+}
+
+func (m deedModule) Describe(cat *catalog.Catalog, character *models.Character, features *models.ClassFeatures) {
+	row := classProgression(cat, character, m.class)
+	if row == nil {
+		return
+	}
+	if row.DeedDie != "" {
+		features.DeedDie = row.DeedDie
+	}
+	if row.ThreatRange > 0 && row.ThreatRange < features.ThreatRange {
+		features.ThreatRange = row.ThreatRange
+	}
+}
+
+func (m deedModule) Rest(character *models.Character) string {
+	return ""
+}
+
+// RollMightyDeed rolls an attack with the character's action die and deed
+// die. The deed roll adds to both attack and damage, and the deed succeeds on
+// a 3 or better if the attack hits.
+func RollMightyDeed(roller *dice.Roller, cat *catalog.Catalog, character *models.Character, missile bool) (*models.MightyDeedResult, *dice.Result, error) {
+	features := ClassFeatures(cat, character)
+	if features.DeedDie == "" {
+		return nil, nil, fmt.Errorf("%s has no deed die", character.Name)
+	}
+
+	deedDie, err := dice.Parse(features.DeedDie)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid deed die '%s': %v", features.DeedDie, err)
+	}
+
+	actionDie := ActionDie(character)
+	result := &models.MightyDeedResult{
+		CharacterID: character.ID,
+		Missile:     missile,
+		ActionDie:   fmt.Sprintf("1d%d", actionDie),
+		DeedDie:     features.DeedDie,
+	}
+
+	if missile {
+		result.AttackBonus = character.Attack + AttributeModifier(character.Agility) + character.MissileAttackBonus
+		result.DamageBonus = character.MissileDamageBonus
+	} else {
+		result.AttackBonus = character.Attack + AttributeModifier(character.Strength) + character.MeleeAttackBonus
+		result.DamageBonus = AttributeModifier(character.Strength) + character.MeleeDamageBonus
+	}
+
+	// Action die and deed die are rolled together as a single attack roll
+	roll := roller.RollExpression(&dice.Expression{
+		Terms:    append([]dice.Term{{Count: 1, Sides: actionDie}}, deedDie.Terms...),
+		Modifier: deedDie.Modifier + result.AttackBonus,
+	})
+
+	result.Natural = roll.Natural()
+	result.AttackTotal = roll.Total
+	result.DeedRoll = roll.Total - result.Natural - result.AttackBonus
+	result.DamageBonus += result.DeedRoll
+	result.Fumble = result.Natural == 1
+	result.Critical = !result.Fumble && result.Natural >= features.ThreatRange
+	result.DeedSucceeded = !result.Fumble && result.DeedRoll >= 3
+
+	return result, roll, nil
+}
@@ -0,0 +1,72 @@
+package rules
+
+import (
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// DerivedStats calculates a character's totals the same way the frontend's
+// updateCalculatedValues does: equipped armor bonuses plus ability modifiers
+// (Agility for AC and Reflex, Stamina for Fortitude, Personality for Willpower).
+// Birth augurs that affect AC or saves and active conditions are applied on
+// top, and carried weight and armor give encumbrance.
+func DerivedStats(cat *catalog.Catalog, character *models.Character) *models.DerivedStats {
+	condAttrs, condSaves, condAC := ConditionModifiers(character)
+
+	stats := &models.DerivedStats{
+		CharacterID:  character.ID,
+		Strength:     deriveAttribute(character.Strength, condAttrs.Strength),
+		Agility:      deriveAttribute(character.Agility, condAttrs.Agility),
+		Stamina:      deriveAttribute(character.Stamina, condAttrs.Stamina),
+		Personality:  deriveAttribute(character.Personality, condAttrs.Personality),
+		Intelligence: deriveAttribute(character.Intelligence, condAttrs.Intelligence),
+		Luck:         deriveAttribute(character.Luck, condAttrs.Luck),
+		Conditions:   []string{},
+	}
+	for _, condition := range character.Conditions {
+		stats.Conditions = append(stats.Conditions, condition.Name)
+	}
+
+	acBonus := 0
+	armorSaves := models.Saves{}
+	for _, item := range character.Equipment {
+		if !item.IsActive || !item.Equipped || item.Category != "armor" {
+			continue
+		}
+		acBonus += item.ACBonus
+		armorSaves.Reflex += item.ReflexSave
+		armorSaves.Fortitude += item.FortitudeSave
+		armorSaves.Willpower += item.WillpowerSave
+	}
+
+	allSaves := BirthAugurModifier(cat, character, "saves")
+
+	stats.ArmorClass = character.ArmorClass + acBonus + stats.Agility.Modifier + BirthAugurModifier(cat, character, "armor-class") + condAC
+	stats.Saves = models.Saves{
+		Reflex:    character.Saves.Reflex + armorSaves.Reflex + stats.Agility.Modifier + allSaves + BirthAugurModifier(cat, character, "reflex") + condSaves.Reflex,
+		Fortitude: character.Saves.Fortitude + armorSaves.Fortitude + stats.Stamina.Modifier + allSaves + BirthAugurModifier(cat, character, "fortitude") + condSaves.Fortitude,
+		Willpower: character.Saves.Willpower + armorSaves.Willpower + stats.Personality.Modifier + allSaves + BirthAugurModifier(cat, character, "willpower") + condSaves.Willpower,
+	}
+
+	stats.OutstandingSpellburn = OutstandingSpellburn(character)
+	stats.Encumbrance = Encumbrance(cat, character)
+
+	return stats
+}
+
+// deriveAttribute applies a condition adjustment to an attribute. The modifier
+// is still based on the base score, shifted by however much the adjustment
+// moves the current score's modifier.
+func deriveAttribute(attr models.Attribute, adjustment int) models.DerivedAttribute {
+	current := CurrentScore(attr)
+	adjusted := current + adjustment
+	if adjusted < 0 {
+		adjusted = 0
+	}
+
+	return models.DerivedAttribute{
+		Base:     attr.Base,
+		Current:  adjusted,
+		Modifier: AttributeModifier(attr) + AbilityModifier(adjusted) - AbilityModifier(current),
+	}
+}
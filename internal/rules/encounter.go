@@ -0,0 +1,217 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// InitiativeModifier returns a character's initiative modifier: Agility,
+// warrior level and the Speed of the cobra augur
+func InitiativeModifier(cat *catalog.Catalog, character *models.Character) int {
+	return AttributeModifier(character.Agility) +
+		ClassLevel(character, "Warrior") +
+		BirthAugurModifier(cat, character, "initiative")
+}
+
+// PlayerCombatant rolls initiative for a character and returns them as a combatant
+func PlayerCombatant(roller *dice.Roller, cat *catalog.Catalog, character *models.Character) (models.Combatant, *dice.Result) {
+	modifier := InitiativeModifier(cat, character)
+	roll := roller.RollExpression(&dice.Expression{
+		Terms:    []dice.Term{{Count: 1, Sides: 20}},
+		Modifier: modifier,
+	})
+
+	combatant := models.Combatant{
+		ID:                 character.ID,
+		CharacterID:        character.ID,
+		Name:               character.Name,
+		IsPlayer:           true,
+		Initiative:         roll.Total,
+		InitiativeModifier: modifier,
+		ArmorClass:         DerivedStats(cat, character).ArmorClass,
+	}
+	SyncCombatant(&combatant, character)
+	return combatant, roll
+}
+
+// MaxMonsterCount is the most NPCs a single monster entry can add to an encounter
+const MaxMonsterCount = 100
+
+// MonsterCombatants rolls initiative and hit points for a group of NPCs.
+// next is the number of NPCs already in the encounter, used for their IDs.
+func MonsterCombatants(roller *dice.Roller, monster models.EncounterMonster, next int) ([]models.Combatant, error) {
+	count := monster.Count
+	if count < 1 {
+		count = 1
+	}
+	if count > MaxMonsterCount {
+		return nil, fmt.Errorf("cannot add %d %s, the most is %d", count, monster.Name, MaxMonsterCount)
+	}
+
+	var combatants []models.Combatant
+	for i := 0; i < count; i++ {
+		name := monster.Name
+		if count > 1 {
+			name = fmt.Sprintf("%s %d", monster.Name, i+1)
+		}
+
+		hitPoints := monster.HitPoints
+		if hitPoints == 0 && monster.HitDice != "" {
+			roll, err := roller.Roll(monster.HitDice)
+			if err != nil {
+				return nil, fmt.Errorf("%s hit dice: %w", monster.Name, err)
+			}
+			hitPoints = roll.Total
+		}
+		if hitPoints < 1 {
+			hitPoints = 1
+		}
+
+		armorClass, initiative := 0, 0
+		if monster.ArmorClass != nil {
+			armorClass = *monster.ArmorClass
+		}
+		if monster.InitiativeModifier != nil {
+			initiative = *monster.InitiativeModifier
+		}
+
+		combatants = append(combatants, models.Combatant{
+			ID:                 fmt.Sprintf("npc-%d", next+i+1),
+			CreatureID:         monster.CreatureID,
+			Name:               name,
+			Initiative:         roller.RollDie(20) + initiative,
+			InitiativeModifier: initiative,
+			CurrentHealth:      hitPoints,
+			MaxHealth:          hitPoints,
+			ArmorClass:         armorClass,
+		})
+	}
+
+	return combatants, nil
+}
+
+// SortInitiative puts combatants in turn order: highest initiative first,
+// ties to the higher modifier and then to PCs
+func SortInitiative(combatants []models.Combatant) {
+	sort.SliceStable(combatants, func(i, j int) bool {
+		a, b := combatants[i], combatants[j]
+		if a.Initiative != b.Initiative {
+			return a.Initiative > b.Initiative
+		}
+		if a.InitiativeModifier != b.InitiativeModifier {
+			return a.InitiativeModifier > b.InitiativeModifier
+		}
+		return a.IsPlayer && !b.IsPlayer
+	})
+}
+
+// SyncCombatant copies a character's hit points and life state onto their combatant
+func SyncCombatant(combatant *models.Combatant, character *models.Character) {
+	combatant.Name = character.Name
+	combatant.CurrentHealth = character.CurrentHealth
+	combatant.MaxHealth = character.MaxHealth
+	combatant.LifeState = LifeState(character)
+	combatant.Defeated = LifeState(character) == models.LifeStateDead
+}
+
+// FindCombatant returns a combatant in an encounter by ID
+func FindCombatant(encounter *models.Encounter, combatantId string) (*models.Combatant, error) {
+	for i := range encounter.Combatants {
+		if encounter.Combatants[i].ID == combatantId {
+			return &encounter.Combatants[i], nil
+		}
+	}
+	return nil, fmt.Errorf("combatant %s not found in %s", combatantId, encounter.Name)
+}
+
+// NextTurn passes the turn to the next combatant who is not defeated. Returns
+// true when a new round begins.
+func NextTurn(encounter *models.Encounter) (bool, error) {
+	if encounter.Ended {
+		return false, fmt.Errorf("%s has ended", encounter.Name)
+	}
+	if len(encounter.Combatants) == 0 {
+		return false, fmt.Errorf("%s has no combatants", encounter.Name)
+	}
+
+	newRound := false
+	for range encounter.Combatants {
+		encounter.Turn++
+		if encounter.Turn >= len(encounter.Combatants) {
+			encounter.Turn = 0
+			encounter.Round++
+			newRound = true
+			LogEncounterEvent(encounter, fmt.Sprintf("Round %d begins", encounter.Round))
+		}
+		if !encounter.Combatants[encounter.Turn].Defeated {
+			break
+		}
+	}
+
+	return newRound, nil
+}
+
+// DamageMonster takes hit points from an NPC, defeating them at 0
+func DamageMonster(combatant *models.Combatant, amount int) {
+	combatant.CurrentHealth -= amount
+	if combatant.CurrentHealth <= 0 {
+		combatant.CurrentHealth = 0
+		combatant.Defeated = true
+	}
+}
+
+// HealMonster restores an NPC's hit points up to their maximum
+func HealMonster(combatant *models.Combatant, amount int) {
+	combatant.CurrentHealth += amount
+	if combatant.CurrentHealth > combatant.MaxHealth {
+		combatant.CurrentHealth = combatant.MaxHealth
+	}
+	if combatant.CurrentHealth > 0 {
+		combatant.Defeated = false
+	}
+}
+
+// DamageCharacter takes hit points from a living character. At 0 HP they
+// start dying, or die outright at level 0.
+func DamageCharacter(character *models.Character, amount int, cause string) error {
+	if !IsAlive(character) {
+		return fmt.Errorf("%s is already %s", character.Name, LifeState(character))
+	}
+
+	character.CurrentHealth -= amount
+	if character.CurrentHealth <= 0 {
+		return StartDying(character, cause)
+	}
+	return nil
+}
+
+// HealCharacter restores a character's hit points up to their maximum. Healing
+// a dying character stabilizes them.
+func HealCharacter(character *models.Character, amount int) error {
+	switch LifeState(character) {
+	case models.LifeStateDead:
+		return fmt.Errorf("%s is dead", character.Name)
+	case models.LifeStateDying:
+		return Stabilize(character, amount)
+	}
+
+	character.CurrentHealth += amount
+	if character.CurrentHealth > character.MaxHealth {
+		character.CurrentHealth = character.MaxHealth
+	}
+	return nil
+}
+
+// LogEncounterEvent appends a line to an encounter's log for the current round
+func LogEncounterEvent(encounter *models.Encounter, message string) {
+	encounter.Log = append(encounter.Log, models.EncounterEvent{
+		Round:     encounter.Round,
+		Message:   message,
+		Timestamp: time.Now(),
+	})
+}
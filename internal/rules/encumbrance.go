@@ -0,0 +1,80 @@
+package rules
+
+import (
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// itemWeight returns the total weight of an equipment row. Like the
+// equipment tab, a quantity below 1 counts as one item.
+func itemWeight(item models.Equipment) float64 {
+	quantity := item.Quantity
+	if quantity < 1 {
+		quantity = 1
+	}
+	return float64(quantity) * item.Weight
+}
+
+// CarriedWeight returns the weight of all of a character's active equipment
+// and of the equipped part of it
+func CarriedWeight(character *models.Character) (carried float64, equipped float64) {
+	for _, item := range character.Equipment {
+		if !item.IsActive {
+			continue
+		}
+		weight := itemWeight(item)
+		carried += weight
+		if item.Equipped {
+			equipped += weight
+		}
+	}
+	return carried, equipped
+}
+
+// EncumbranceTier returns the catalog tier for a carried weight and Strength
+// score, and the most weight carried without penalty
+func EncumbranceTier(cat *catalog.Catalog, weight float64, strength int) (*catalog.EncumbranceTier, float64) {
+	if cat == nil || len(cat.Encumbrance) == 0 {
+		return nil, 0
+	}
+
+	capacity := cat.Encumbrance[0].MaxWeightPerStrength * float64(strength)
+	for i := range cat.Encumbrance {
+		tier := &cat.Encumbrance[i]
+		if tier.MaxWeightPerStrength == 0 || weight <= tier.MaxWeightPerStrength*float64(strength) {
+			return tier, capacity
+		}
+	}
+	return &cat.Encumbrance[len(cat.Encumbrance)-1], capacity
+}
+
+// Encumbrance calculates carried weight, the encumbrance tier from current
+// Strength, and the combined speed and check penalties from armor and load
+func Encumbrance(cat *catalog.Catalog, character *models.Character) models.Encumbrance {
+	carried, equipped := CarriedWeight(character)
+
+	enc := models.Encumbrance{
+		CarriedWeight:     carried,
+		EquippedWeight:    equipped,
+		ArmorSpeedPenalty: ArmorSpeedPenalty(cat, character),
+		ArmorCheckPenalty: ArmorCheckPenalty(cat, character),
+		FumbleDie:         FumbleDie(cat, character),
+	}
+	enc.SpeedPenalty = enc.ArmorSpeedPenalty
+	enc.CheckPenalty = enc.ArmorCheckPenalty
+
+	tier, capacity := EncumbranceTier(cat, carried, CurrentScore(character.Strength))
+	enc.Capacity = capacity
+	if tier != nil {
+		enc.Tier = tier.Name
+		enc.SpeedPenalty += tier.SpeedPenalty
+		enc.CheckPenalty += tier.CheckPenalty
+	}
+
+	enc.Speed = character.Speed + enc.SpeedPenalty
+	if enc.Speed < 0 {
+		enc.Speed = 0
+	}
+
+	return enc
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// ExperienceThresholds is the DCC XP table. The index is the level and the
+// value is the total XP required to reach it.
+var ExperienceThresholds = []int{0, 10, 50, 110, 190, 290, 410, 550, 710, 890, 1090}
+
+// MaxLevel is the highest level on the XP table
+const MaxLevel = 10
+
+// ExperienceForLevel returns the total XP required to reach a level
+func ExperienceForLevel(level int) int {
+	if level <= 0 {
+		return 0
+	}
+	if level > MaxLevel {
+		level = MaxLevel
+	}
+	return ExperienceThresholds[level]
+}
+
+// LevelForExperience returns the highest level a total XP amount qualifies for
+func LevelForExperience(total int) int {
+	level := 0
+	for l, threshold := range ExperienceThresholds {
+		if total >= threshold {
+			level = l
+		}
+	}
+	return level
+}
+
+// SyncExperience recomputes CurrentExperience and ExperienceNeeded from
+// TotalExperience and Level. CurrentExperience is the XP earned since
+// reaching the current level and ExperienceNeeded is the size of the
+// current level's span, so the two read as progress toward the next level.
+// A character whose level was set above their XP shows no progress rather
+// than a negative amount.
+func SyncExperience(character *models.Character) {
+	if character.TotalExperience < 0 {
+		character.TotalExperience = 0
+	}
+
+	floor := ExperienceForLevel(character.Level)
+	character.CurrentExperience = character.TotalExperience - floor
+	if character.CurrentExperience < 0 {
+		character.CurrentExperience = 0
+	}
+
+	if character.Level >= MaxLevel {
+		character.ExperienceNeeded = 0
+		return
+	}
+
+	character.ExperienceNeeded = ExperienceForLevel(character.Level+1) - floor
+}
+
+// CanLevelUp reports whether a character has enough XP for their next level
+func CanLevelUp(character *models.Character) bool {
+	return character.Level < MaxLevel && LevelForExperience(character.TotalExperience) > character.Level
+}
+
+// AwardExperience adds XP to a character and keeps the experience fields consistent
+func AwardExperience(character *models.Character, amount int) error {
+	if amount <= 0 {
+		return fmt.Errorf("XP award must be positive")
+	}
+
+	character.TotalExperience += amount
+	SyncExperience(character)
+	return nil
+}
+
+// ExperienceStatus summarizes a character's progress toward their next level
+func ExperienceStatus(character *models.Character) models.ExperienceStatus {
+	status := models.ExperienceStatus{
+		CharacterID:       character.ID,
+		CharacterName:     character.Name,
+		Level:             character.Level,
+		TotalExperience:   character.TotalExperience,
+		CurrentExperience: character.CurrentExperience,
+		ExperienceNeeded:  character.ExperienceNeeded,
+		CanLevelUp:        CanLevelUp(character),
+	}
+
+	if character.Level < MaxLevel {
+		status.NextLevelAt = ExperienceForLevel(character.Level + 1)
+	}
+
+	return status
+}
+
+// PlanPartyExperience works out each member's XP from a party award. Inactive,
+// dead and absent members get nothing. A split divides the amount evenly,
+// except in a funnel: when every recipient is level 0, each survivor earns
+// the full amount. Returns the recipients and the result to fill in.
+func PlanPartyExperience(partyId string, members []*models.Character, amount int, options models.PartyExperienceOptions) ([]*models.Character, *models.PartyExperienceResult, error) {
+	if amount <= 0 {
+		return nil, nil, fmt.Errorf("XP award must be positive")
+	}
+
+	absent := map[string]bool{}
+	for _, id := range options.Absent {
+		absent[id] = true
+	}
+
+	result := &models.PartyExperienceResult{
+		PartyID: partyId,
+		Session: options.Session,
+		Awarded: []models.ExperienceStatus{},
+		Skipped: []string{},
+	}
+	if result.Session == "" {
+		result.Session = models.SessionKey(time.Now())
+	}
+
+	var recipients []*models.Character
+	funnel := true
+	for _, member := range members {
+		if !member.IsActive || LifeState(member) == models.LifeStateDead || absent[member.ID] {
+			result.Skipped = append(result.Skipped, member.Name)
+			continue
+		}
+		if member.Level > 0 {
+			funnel = false
+		}
+		recipients = append(recipients, member)
+	}
+
+	if len(recipients) == 0 {
+		return nil, nil, fmt.Errorf("no one in the party can receive XP")
+	}
+
+	result.AmountEach = amount
+	if options.Split {
+		if funnel {
+			result.FunnelRule = true
+		} else {
+			result.AmountEach = amount / len(recipients)
+			result.Remainder = amount % len(recipients)
+			if result.AmountEach == 0 {
+				return nil, nil, fmt.Errorf("%d XP is too little to split among %d members", amount, len(recipients))
+			}
+		}
+	}
+
+	return recipients, result, nil
+}
@@ -0,0 +1,111 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+func TestLevelForExperience(t *testing.T) {
+	tests := []struct {
+		total int
+		want  int
+	}{
+		{0, 0},
+		{9, 0},
+		{10, 1},
+		{49, 1},
+		{50, 2},
+		{109, 2},
+		{110, 3},
+		{290, 5},
+		{889, 8},
+		{890, 9},
+		{1089, 9},
+		{1090, 10},
+		{5000, 10},
+		{-5, 0},
+	}
+
+	for _, tt := range tests {
+		if got := LevelForExperience(tt.total); got != tt.want {
+			t.Errorf("LevelForExperience(%d) = %d, want %d", tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestExperienceForLevel(t *testing.T) {
+	tests := []struct {
+		level int
+		want  int
+	}{
+		{-1, 0},
+		{0, 0},
+		{1, 10},
+		{5, 290},
+		{10, 1090},
+		{11, 1090},
+	}
+
+	for _, tt := range tests {
+		if got := ExperienceForLevel(tt.level); got != tt.want {
+			t.Errorf("ExperienceForLevel(%d) = %d, want %d", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestSyncExperience(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       int
+		total       int
+		wantTotal   int
+		wantCurrent int
+		wantNeeded  int
+		canLevelUp  bool
+	}{
+		{"fresh funnel character", 0, 0, 0, 0, 10, false},
+		{"one short of level 1", 0, 9, 9, 9, 10, false},
+		{"exactly enough for level 1", 0, 10, 10, 10, 10, true},
+		{"start of level 1", 1, 10, 10, 0, 40, false},
+		{"one short of level 3", 2, 109, 109, 59, 60, false},
+		{"exactly enough for level 3", 2, 110, 110, 60, 60, true},
+		{"level set above XP", 3, 20, 20, 0, 80, false},
+		{"negative total", 1, -10, 0, 0, 40, false},
+		{"top of the table", 10, 2000, 2000, 910, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			character := &models.Character{Level: tt.level, TotalExperience: tt.total}
+			SyncExperience(character)
+
+			if character.TotalExperience != tt.wantTotal {
+				t.Errorf("TotalExperience = %d, want %d", character.TotalExperience, tt.wantTotal)
+			}
+			if character.CurrentExperience != tt.wantCurrent {
+				t.Errorf("CurrentExperience = %d, want %d", character.CurrentExperience, tt.wantCurrent)
+			}
+			if character.ExperienceNeeded != tt.wantNeeded {
+				t.Errorf("ExperienceNeeded = %d, want %d", character.ExperienceNeeded, tt.wantNeeded)
+			}
+			if got := CanLevelUp(character); got != tt.canLevelUp {
+				t.Errorf("CanLevelUp = %v, want %v", got, tt.canLevelUp)
+			}
+		})
+	}
+}
+
+func TestAwardExperienceRejects(t *testing.T) {
+	tests := []int{0, -1, -100}
+
+	for _, amount := range tests {
+		character := &models.Character{TotalExperience: 5}
+		if err := AwardExperience(character, amount); err == nil {
+			t.Errorf("AwardExperience(%d) succeeded, want error", amount)
+		}
+		if character.TotalExperience != 5 {
+			t.Errorf("AwardExperience(%d) changed TotalExperience to %d", amount, character.TotalExperience)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// GenerateFunnelCharacter rolls up a complete zero-level character: 3d6 in order
+// for each attribute, 1d4 + Stamina modifier hit points, an occupation with its
+// trained weapon and trade goods, a birth augur and 5d12 copper in their purse.
+func GenerateFunnelCharacter(roller *dice.Roller, cat *catalog.Catalog, id string, name string, alignment int) (*models.Character, error) {
+	character := &models.Character{
+		ID:               id,
+		Name:             name,
+		Level:            0,
+		Alignment:        alignment,
+		ArmorClass:       10,
+		Speed:            30,
+		IsActive:         true,
+		ActionDice:       "1d20",
+		CritDice:         "1d4",
+		CritTable:        "I",
+		ExperienceNeeded: 10,
+		Equipment:        []models.Equipment{},
+		Abilities:        []models.Ability{},
+		Classes:          []models.Class{},
+		Tables:           []models.Table{},
+		History:          []models.HistoryEntry{},
+	}
+
+	// Attributes are rolled 3d6 in order
+	for _, attr := range []*models.Attribute{
+		&character.Strength,
+		&character.Agility,
+		&character.Stamina,
+		&character.Personality,
+		&character.Intelligence,
+		&character.Luck,
+	} {
+		result, err := roller.Roll("3d6")
+		if err != nil {
+			return nil, err
+		}
+		attr.Base = result.Total
+	}
+
+	hp := roller.RollDie(4) + AttributeModifier(character.Stamina)
+	if hp < 1 {
+		hp = 1
+	}
+	character.MaxHealth = hp
+	character.CurrentHealth = hp
+
+	occupation, err := cat.OccupationForRoll(roller.RollDie(100))
+	if err != nil {
+		return nil, err
+	}
+	character.Occupation = occupation.Name
+	if occupation.Race == "dwarf" || occupation.Race == "halfling" {
+		character.Speed = 20
+	}
+
+	sign, err := cat.LuckSignForRoll(roller.RollDie(30))
+	if err != nil {
+		return nil, err
+	}
+	character.BirthAugur = fmt.Sprintf("%s: %s", sign.Name, sign.Affects)
+
+	copper, err := roller.Roll("5d12")
+	if err != nil {
+		return nil, err
+	}
+
+	character.Equipment = append(character.Equipment,
+		models.Equipment{
+			ID:         fmt.Sprintf("%s-eq-weapon", id),
+			Name:       occupation.TrainedWeapon,
+			Quantity:   1,
+			Category:   "weapon",
+			Equipped:   true,
+			DamageDice: occupation.WeaponDamage,
+			IsActive:   true,
+		},
+		models.Equipment{
+			ID:       fmt.Sprintf("%s-eq-goods", id),
+			Name:     occupation.TradeGoods,
+			Quantity: 1,
+			Category: "item",
+			IsActive: true,
+		},
+	)
+	character.Purse.CP = copper.Total
+
+	return character, nil
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+)
+
+func TestGenerateFunnelCharacter(t *testing.T) {
+	cat, err := catalog.Default()
+	if err != nil {
+		t.Fatalf("catalog.Default returned error: %v", err)
+	}
+
+	tests := []struct {
+		seed      int64
+		alignment int
+	}{
+		{1, 0},
+		{2, 1},
+		{3, 2},
+		{42, 0},
+		{1000, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("seed %d", tt.seed), func(t *testing.T) {
+			character, err := GenerateFunnelCharacter(dice.NewSeededRoller(tt.seed), cat, "character-1", "Peasant 1", tt.alignment)
+			if err != nil {
+				t.Fatalf("GenerateFunnelCharacter returned error: %v", err)
+			}
+
+			if character.ID != "character-1" || character.Name != "Peasant 1" || character.Level != 0 || !character.IsActive {
+				t.Errorf("character = %s %q level %d active %v, want a new active level 0 character", character.ID, character.Name, character.Level, character.IsActive)
+			}
+			if character.Alignment != tt.alignment {
+				t.Errorf("Alignment = %d, want %d", character.Alignment, tt.alignment)
+			}
+
+			for name, score := range map[string]int{
+				"Strength":     character.Strength.Base,
+				"Agility":      character.Agility.Base,
+				"Stamina":      character.Stamina.Base,
+				"Personality":  character.Personality.Base,
+				"Intelligence": character.Intelligence.Base,
+				"Luck":         character.Luck.Base,
+			} {
+				if score < 3 || score > 18 {
+					t.Errorf("%s = %d, want 3-18", name, score)
+				}
+			}
+
+			maxHP := 4 + AttributeModifier(character.Stamina)
+			if maxHP < 1 {
+				maxHP = 1
+			}
+			if character.MaxHealth < 1 || character.MaxHealth > maxHP || character.CurrentHealth != character.MaxHealth {
+				t.Errorf("HP = %d/%d, want full HP between 1 and %d", character.CurrentHealth, character.MaxHealth, maxHP)
+			}
+
+			occupation, err := cat.GetOccupation(character.Occupation)
+			if err != nil {
+				t.Fatalf("Occupation %q is not in the catalog", character.Occupation)
+			}
+			wantSpeed := 30
+			if occupation.Race == "dwarf" || occupation.Race == "halfling" {
+				wantSpeed = 20
+			}
+			if character.Speed != wantSpeed {
+				t.Errorf("Speed = %d, want %d for %s", character.Speed, wantSpeed, occupation.Name)
+			}
+
+			if len(character.Equipment) != 2 {
+				t.Fatalf("Equipment = %+v, want a weapon and trade goods", character.Equipment)
+			}
+			if weapon := character.Equipment[0]; weapon.Name != occupation.TrainedWeapon || !weapon.Equipped || weapon.DamageDice != occupation.WeaponDamage {
+				t.Errorf("weapon = %+v, want %s (%s) equipped", weapon, occupation.TrainedWeapon, occupation.WeaponDamage)
+			}
+			if goods := character.Equipment[1]; goods.Name != occupation.TradeGoods {
+				t.Errorf("trade goods = %q, want %q", goods.Name, occupation.TradeGoods)
+			}
+
+			if character.Purse.CP < 5 || character.Purse.CP > 60 {
+				t.Errorf("Purse.CP = %d, want 5-60", character.Purse.CP)
+			}
+			if character.BirthAugur == "" {
+				t.Errorf("BirthAugur is empty")
+			}
+
+			if result := ValidateCharacter(cat, character, nil); !result.Valid {
+				t.Errorf("ValidateCharacter errors = %+v", result.Errors)
+			}
+
+			again, err := GenerateFunnelCharacter(dice.NewSeededRoller(tt.seed), cat, "character-1", "Peasant 1", tt.alignment)
+			if err != nil {
+				t.Fatalf("GenerateFunnelCharacter returned error: %v", err)
+			}
+			if !reflect.DeepEqual(character, again) {
+				t.Errorf("the same seed rolled a different character:\n%+v\n%+v", character, again)
+			}
+		})
+	}
+}
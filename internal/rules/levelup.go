@@ -0,0 +1,157 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// LevelUp advances one of a character's classes by a level using the class
+// progression table. classID is the ID of an entry in Character.Classes; a
+// class name from the catalog is also accepted, which advances the character's
+// class of that name or adds it at level 0 first (e.g. a funnel survivor
+// taking their first class). Character.Level is the sum of active class levels.
+// Action and crit dice and Character.Class follow the primary class, the
+// character's highest-level class.
+func LevelUp(roller *dice.Roller, cat *catalog.Catalog, character *models.Character, classID string) (*models.LevelUpResult, error) {
+	if !IsAlive(character) {
+		return nil, fmt.Errorf("%s is %s and cannot level up", character.Name, LifeState(character))
+	}
+	if !CanLevelUp(character) {
+		return nil, fmt.Errorf("%s needs %d XP to reach level %d", character.Name, ExperienceForLevel(character.Level+1), character.Level+1)
+	}
+
+	idx := -1
+	for i, class := range character.Classes {
+		if class.ID == classID && class.IsActive {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		progression, err := cat.GetClass(classID)
+		if err != nil {
+			return nil, fmt.Errorf("class '%s' not found on character", classID)
+		}
+
+		// A class the character already has is advanced rather than added again
+		for i, class := range character.Classes {
+			if class.IsActive && strings.EqualFold(strings.TrimSpace(class.Name), progression.Name) {
+				idx = i
+				break
+			}
+		}
+
+		if idx == -1 {
+			character.Classes = append(character.Classes, models.Class{
+				ID:       fmt.Sprintf("class-%d", time.Now().UnixMilli()),
+				Name:     progression.Name,
+				Level:    0,
+				IsActive: true,
+			})
+			idx = len(character.Classes) - 1
+		}
+	}
+
+	class := &character.Classes[idx]
+	progression, err := cat.GetClass(strings.TrimSpace(class.Name))
+	if err != nil {
+		return nil, fmt.Errorf("no progression table for class '%s'", class.Name)
+	}
+
+	newLevel := class.Level + 1
+	if newLevel > progression.MaxLevel() {
+		return nil, fmt.Errorf("%s is already at the maximum level (%d)", progression.Name, progression.MaxLevel())
+	}
+
+	next, err := progression.Level(newLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	// Level 0 has no table row, so everything is gained relative to zero
+	previous := &catalog.LevelProgression{}
+	if class.Level > 0 {
+		previous, err = progression.Level(class.Level)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hitDie, err := roller.Roll(progression.HitDie)
+	if err != nil {
+		return nil, err
+	}
+
+	gained := hitDie.Total + AttributeModifier(character.Stamina) + BirthAugurModifier(cat, character, "hit-points")
+	if gained < 1 {
+		gained = 1
+	}
+
+	character.MaxHealth += gained
+	character.CurrentHealth += gained
+
+	// Bonuses are applied as deltas so manual adjustments are kept
+	character.Attack += next.AttackBonus - previous.AttackBonus
+	character.Saves.Reflex += next.Reflex - previous.Reflex
+	character.Saves.Fortitude += next.Fortitude - previous.Fortitude
+	character.Saves.Willpower += next.Willpower - previous.Willpower
+
+	class.Level = newLevel
+	character.Level = totalClassLevel(character)
+
+	primary := primaryClass(character)
+	primaryProgression, err := cat.GetClass(strings.TrimSpace(primary.Name))
+	if err != nil {
+		return nil, fmt.Errorf("no progression table for class '%s'", primary.Name)
+	}
+	primaryLevel, err := primaryProgression.Level(primary.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	character.ActionDice = primaryLevel.ActionDice
+	character.CritDice = primaryLevel.CritDie
+	character.CritTable = primaryLevel.CritTable
+	character.Class = primaryProgression.Name
+	SyncExperience(character)
+
+	return &models.LevelUpResult{
+		Character:       character,
+		ClassName:       progression.Name,
+		Level:           newLevel,
+		HitDie:          progression.HitDie,
+		HitPointRoll:    hitDie.Total,
+		HitPointsGained: gained,
+		DeedDie:         next.DeedDie,
+	}, nil
+}
+
+// totalClassLevel returns the sum of a character's active class levels
+func totalClassLevel(character *models.Character) int {
+	total := 0
+	for _, class := range character.Classes {
+		if class.IsActive {
+			total += class.Level
+		}
+	}
+	return total
+}
+
+// primaryClass returns a character's highest-level active class, the earliest
+// one on a tie. The character must have at least one active class.
+func primaryClass(character *models.Character) *models.Class {
+	var primary *models.Class
+	for i := range character.Classes {
+		class := &character.Classes[i]
+		if class.IsActive && (primary == nil || class.Level > primary.Level) {
+			primary = class
+		}
+	}
+	return primary
+}
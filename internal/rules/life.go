@@ -0,0 +1,185 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// bodyRecoveryRounds is how long after death the body can still be recovered (one hour)
+const bodyRecoveryRounds = roundsPerHour
+
+// LifeState returns a character's life state, treating an unset state as alive
+func LifeState(character *models.Character) string {
+	if character.Life.State == "" {
+		return models.LifeStateAlive
+	}
+	return character.Life.State
+}
+
+// IsAlive reports whether a character is alive (not dying or dead)
+func IsAlive(character *models.Character) bool {
+	return LifeState(character) == models.LifeStateAlive
+}
+
+// StartDying drops a character to 0 HP. Level 0 characters die outright;
+// others bleed out over a number of rounds equal to their level.
+func StartDying(character *models.Character, cause string) error {
+	if LifeState(character) != models.LifeStateAlive {
+		return fmt.Errorf("%s is already %s", character.Name, LifeState(character))
+	}
+
+	character.CurrentHealth = 0
+	if character.Level < 1 {
+		Kill(character, cause)
+		return nil
+	}
+
+	character.Life = models.LifeStatus{
+		State:           models.LifeStateDying,
+		RoundsRemaining: character.Level,
+		Cause:           cause,
+	}
+	return nil
+}
+
+// Kill marks a character as dead and records the cause and session
+func Kill(character *models.Character, cause string) {
+	if cause == "" {
+		cause = character.Life.Cause
+	}
+
+	now := time.Now()
+	character.CurrentHealth = 0
+	character.Life = models.LifeStatus{
+		State:   models.LifeStateDead,
+		Cause:   cause,
+		Session: models.SessionKey(now),
+		DiedAt:  now,
+	}
+}
+
+// AdvanceLifeState passes rounds for a dying or dead character. Dying
+// characters bleed out; dead characters move further from recovery.
+// Returns true if the character bled out.
+func AdvanceLifeState(character *models.Character, rounds int) bool {
+	if rounds <= 0 {
+		return false
+	}
+
+	switch LifeState(character) {
+	case models.LifeStateDying:
+		character.Life.RoundsRemaining -= rounds
+		if character.Life.RoundsRemaining <= 0 {
+			Kill(character, "")
+			return true
+		}
+	case models.LifeStateDead:
+		character.Life.RoundsDead += rounds
+	}
+	return false
+}
+
+// Stabilize heals a dying character before they bleed out. They survive
+// with a permanent scar: -1 Stamina.
+func Stabilize(character *models.Character, hitPoints int) error {
+	if LifeState(character) != models.LifeStateDying {
+		return fmt.Errorf("%s is not dying", character.Name)
+	}
+	if hitPoints < 1 {
+		hitPoints = 1
+	}
+	if hitPoints > character.MaxHealth {
+		hitPoints = character.MaxHealth
+	}
+
+	character.CurrentHealth = hitPoints
+	loseAttributePoint(&character.Stamina)
+	character.Life = models.LifeStatus{State: models.LifeStateAlive}
+	return nil
+}
+
+// RecoverBody rolls over a dead character's body within an hour of death. On
+// a Luck check (d20 at or under current Luck) they were only knocked out: they
+// return with 1 HP, are groggy for an hour and permanently lose a point of
+// Strength, Agility or Stamina. A body can only be rolled once.
+func RecoverBody(roller *dice.Roller, character *models.Character) (*models.BodyRecoveryResult, error) {
+	if LifeState(character) != models.LifeStateDead {
+		return nil, fmt.Errorf("%s is not dead", character.Name)
+	}
+	if character.Life.BodyRecovered {
+		return nil, fmt.Errorf("%s's body has already been recovered", character.Name)
+	}
+	if character.Life.RoundsDead > bodyRecoveryRounds {
+		return nil, fmt.Errorf("%s has been dead for more than an hour", character.Name)
+	}
+
+	result := &models.BodyRecoveryResult{
+		Character: character,
+		Roll:      roller.RollDie(20),
+		Luck:      CurrentScore(character.Luck),
+	}
+	character.Life.BodyRecovered = true
+
+	if result.Roll > result.Luck {
+		return result, nil
+	}
+
+	result.Success = true
+	switch roller.RollDie(3) {
+	case 1:
+		loseAttributePoint(&character.Strength)
+		result.StatLoss = "Strength"
+	case 2:
+		loseAttributePoint(&character.Agility)
+		result.StatLoss = "Agility"
+	default:
+		loseAttributePoint(&character.Stamina)
+		result.StatLoss = "Stamina"
+	}
+
+	character.CurrentHealth = 1
+	character.Life = models.LifeStatus{State: models.LifeStateAlive}
+
+	if _, err := ApplyCondition(character, models.Condition{
+		Name:     "Groggy",
+		Source:   "Recovered from near death",
+		Saves:    models.Saves{Reflex: -4, Fortitude: -4, Willpower: -4},
+		Duration: models.GameDuration{Value: 1, Unit: models.TimeUnitHours},
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GraveyardEntry summarizes a dead character for the graveyard view
+func GraveyardEntry(character *models.Character) models.GraveyardEntry {
+	return models.GraveyardEntry{
+		CharacterID:   character.ID,
+		CharacterName: character.Name,
+		Level:         character.Level,
+		Class:         character.Class,
+		Occupation:    character.Occupation,
+		Cause:         character.Life.Cause,
+		Session:       character.Life.Session,
+		DiedAt:        character.Life.DiedAt,
+		BodyRecovered: character.Life.BodyRecovered,
+	}
+}
+
+// loseAttributePoint permanently lowers an attribute's base score, keeping
+// any temporary score in step
+func loseAttributePoint(attr *models.Attribute) {
+	if attr.Base > 1 {
+		attr.Base--
+	}
+	if attr.Temporary > attr.Base {
+		attr.Temporary = attr.Base
+	}
+	if attr.Temporary == attr.Base {
+		attr.Temporary = 0
+	}
+}
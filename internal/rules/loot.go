@@ -0,0 +1,191 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// PlanLootSplit divides loot among a party's members by the strategy's
+// method. Coins are split denomination by denomination first; what is left
+// over is split by value, paid in gold, silver and copper. Item stacks are
+// split by unit. Anything that can't be divided evenly is the remainder.
+func PlanLootSplit(partyId string, members []*models.Character, loot models.Loot, strategy models.LootStrategy) (*models.LootSplitResult, error) {
+	if err := validateLoot(loot); err != nil {
+		return nil, err
+	}
+	if strategy.Method == "" {
+		strategy.Method = models.LootSplitEven
+	}
+	if strategy.RemainderTo == "" {
+		strategy.RemainderTo = models.LootRemainderStash
+	}
+
+	absent := map[string]bool{}
+	for _, id := range strategy.Absent {
+		absent[id] = true
+	}
+
+	result := &models.LootSplitResult{
+		PartyID:    partyId,
+		Method:     strategy.Method,
+		Recipients: []models.LootShare{},
+		Remainder:  models.LootShare{Items: []models.Equipment{}},
+		Skipped:    []string{},
+	}
+
+	remainderFound := strategy.RemainderTo == models.LootRemainderStash
+	for _, member := range members {
+		if member.ID == strategy.RemainderTo {
+			remainderFound = true
+			result.Remainder.CharacterID = member.ID
+			result.Remainder.CharacterName = member.Name
+		}
+
+		if !member.IsActive {
+			result.Skipped = append(result.Skipped, member.Name)
+			continue
+		}
+
+		shares := 1
+		switch strategy.Method {
+		case models.LootSplitEven:
+		case models.LootSplitShares:
+			if n, ok := strategy.Shares[member.ID]; ok {
+				shares = n
+			}
+		case models.LootSplitLiving:
+			if LifeState(member) == models.LifeStateDead || absent[member.ID] {
+				shares = 0
+			}
+		default:
+			return nil, fmt.Errorf("unknown loot split method '%s'", strategy.Method)
+		}
+
+		if shares < 0 {
+			return nil, fmt.Errorf("%s cannot have negative shares", member.Name)
+		}
+		if shares == 0 {
+			result.Skipped = append(result.Skipped, member.Name)
+			continue
+		}
+
+		result.Recipients = append(result.Recipients, models.LootShare{
+			CharacterID:   member.ID,
+			CharacterName: member.Name,
+			Shares:        shares,
+			Items:         []models.Equipment{},
+		})
+	}
+
+	if !remainderFound {
+		return nil, fmt.Errorf("remainder recipient %s is not in the party", strategy.RemainderTo)
+	}
+	if len(result.Recipients) == 0 {
+		return nil, fmt.Errorf("no one is eligible for a share of the loot")
+	}
+
+	totalShares := 0
+	for _, recipient := range result.Recipients {
+		totalShares += recipient.Shares
+	}
+
+	splitCoins(result, loot.Coins, totalShares)
+	splitItems(result, loot.Items, totalShares)
+
+	return result, nil
+}
+
+// validateLoot rejects negative coin counts and items with no quantity, which
+// would otherwise take coins and items away from the recipients
+func validateLoot(loot models.Loot) error {
+	for _, d := range denominations {
+		if count := *d.count(&loot.Coins); count < 0 {
+			return fmt.Errorf("loot cannot have a negative number of %s (%d)", d.name, count)
+		}
+	}
+	for _, item := range loot.Items {
+		if item.Quantity < 1 {
+			return fmt.Errorf("%s must have a quantity of at least 1", item.Name)
+		}
+	}
+	return nil
+}
+
+// splitCoins gives each recipient their share of each denomination, then
+// splits the leftover value
+func splitCoins(result *models.LootSplitResult, coins models.Purse, totalShares int) {
+	leftover := 0
+	for _, d := range denominations {
+		count := *d.count(&coins)
+		given := 0
+		for i := range result.Recipients {
+			share := count * result.Recipients[i].Shares / totalShares
+			*d.count(&result.Recipients[i].Coins) += share
+			given += share
+		}
+		leftover += (count - given) * d.value
+	}
+
+	given := 0
+	for i := range result.Recipients {
+		share := leftover * result.Recipients[i].Shares / totalShares
+		makeChange(&result.Recipients[i].Coins, share)
+		given += share
+	}
+	makeChange(&result.Remainder.Coins, leftover-given)
+}
+
+// splitItems gives each recipient their share of each item stack
+func splitItems(result *models.LootSplitResult, items []models.Equipment, totalShares int) {
+	base := time.Now().UnixNano()
+	for n, item := range items {
+		if item.ID == "" {
+			item.ID = fmt.Sprintf("loot-%d-%d", base, n)
+		}
+		item.IsActive = true
+		item.Equipped = false
+
+		quantity := item.Quantity
+		given := 0
+		for i := range result.Recipients {
+			share := quantity * result.Recipients[i].Shares / totalShares
+			if share == 0 {
+				continue
+			}
+			portion := item
+			portion.ID = fmt.Sprintf("%s-%d", item.ID, i+1)
+			portion.Quantity = share
+			result.Recipients[i].Items = append(result.Recipients[i].Items, portion)
+			given += share
+		}
+
+		if given < quantity {
+			portion := item
+			portion.Quantity = quantity - given
+			if given > 0 {
+				portion.ID = fmt.Sprintf("%s-r", item.ID)
+			}
+			result.Remainder.Items = append(result.Remainder.Items, portion)
+		}
+	}
+}
+
+// DescribeLoot describes coins and items, e.g. "12 gp, 3 sp, 2 × Torch"
+func DescribeLoot(coins models.Purse, items []models.Equipment) string {
+	description := ""
+	if coins != (models.Purse{}) {
+		description = FormatPurse(coins)
+	}
+	for _, item := range items {
+		if description != "" {
+			description += ", "
+		}
+		description += DescribeStack(item)
+	}
+	if description == "" {
+		return "nothing"
+	}
+	return description
+}
@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+func lootMembers() []*models.Character {
+	return []*models.Character{
+		{ID: "a", Name: "Ash", IsActive: true},
+		{ID: "b", Name: "Bram", IsActive: true},
+		{ID: "c", Name: "Cole", IsActive: true},
+	}
+}
+
+func TestPlanLootSplit(t *testing.T) {
+	dead := lootMembers()
+	dead[1].Life.State = models.LifeStateDead
+
+	retired := lootMembers()
+	retired[2].IsActive = false
+
+	tests := []struct {
+		name          string
+		members       []*models.Character
+		loot          models.Loot
+		strategy      models.LootStrategy
+		wantCoins     []models.Purse
+		wantRemainder models.Purse
+		wantSkipped   []string
+	}{
+		{
+			name:          "even coins",
+			members:       lootMembers(),
+			loot:          models.Loot{Coins: models.Purse{GP: 9, SP: 3}},
+			wantCoins:     []models.Purse{{GP: 3, SP: 1}, {GP: 3, SP: 1}, {GP: 3, SP: 1}},
+			wantRemainder: models.Purse{},
+			wantSkipped:   []string{},
+		},
+		{
+			name:          "leftover coins are split by value",
+			members:       lootMembers(),
+			loot:          models.Loot{Coins: models.Purse{GP: 10, SP: 2}},
+			wantCoins:     []models.Purse{{GP: 3, SP: 4}, {GP: 3, SP: 4}, {GP: 3, SP: 4}},
+			wantRemainder: models.Purse{},
+			wantSkipped:   []string{},
+		},
+		{
+			name:          "indivisible copper is the remainder",
+			members:       lootMembers(),
+			loot:          models.Loot{Coins: models.Purse{CP: 10}},
+			wantCoins:     []models.Purse{{CP: 3}, {CP: 3}, {CP: 3}},
+			wantRemainder: models.Purse{CP: 1},
+			wantSkipped:   []string{},
+		},
+		{
+			name:          "weighted shares",
+			members:       lootMembers()[:2],
+			loot:          models.Loot{Coins: models.Purse{GP: 10}},
+			strategy:      models.LootStrategy{Method: models.LootSplitShares, Shares: map[string]int{"a": 2}},
+			wantCoins:     []models.Purse{{GP: 6, SP: 6, CP: 6}, {GP: 3, SP: 3, CP: 3}},
+			wantRemainder: models.Purse{CP: 1},
+			wantSkipped:   []string{},
+		},
+		{
+			name:          "zero shares are skipped",
+			members:       lootMembers(),
+			loot:          models.Loot{Coins: models.Purse{GP: 4}},
+			strategy:      models.LootStrategy{Method: models.LootSplitShares, Shares: map[string]int{"c": 0}},
+			wantCoins:     []models.Purse{{GP: 2}, {GP: 2}},
+			wantRemainder: models.Purse{},
+			wantSkipped:   []string{"Cole"},
+		},
+		{
+			name:          "living skips the dead and absent",
+			members:       dead,
+			loot:          models.Loot{Coins: models.Purse{GP: 3}},
+			strategy:      models.LootStrategy{Method: models.LootSplitLiving, Absent: []string{"c"}, RemainderTo: "a"},
+			wantCoins:     []models.Purse{{GP: 3}},
+			wantRemainder: models.Purse{},
+			wantSkipped:   []string{"Bram", "Cole"},
+		},
+		{
+			name:          "inactive members get nothing",
+			members:       retired,
+			loot:          models.Loot{Coins: models.Purse{GP: 5}},
+			strategy:      models.LootStrategy{RemainderTo: "b"},
+			wantCoins:     []models.Purse{{GP: 2, SP: 5}, {GP: 2, SP: 5}},
+			wantRemainder: models.Purse{},
+			wantSkipped:   []string{"Cole"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PlanLootSplit("party", tt.members, tt.loot, tt.strategy)
+			if err != nil {
+				t.Fatalf("PlanLootSplit returned error: %v", err)
+			}
+
+			var coins []models.Purse
+			total := got.Remainder.Coins
+			for _, recipient := range got.Recipients {
+				coins = append(coins, recipient.Coins)
+				total = addPurse(total, recipient.Coins)
+			}
+			if !reflect.DeepEqual(coins, tt.wantCoins) {
+				t.Errorf("recipient coins = %+v, want %+v", coins, tt.wantCoins)
+			}
+			if got.Remainder.Coins != tt.wantRemainder {
+				t.Errorf("remainder coins = %+v, want %+v", got.Remainder.Coins, tt.wantRemainder)
+			}
+			if PurseValue(total) != PurseValue(tt.loot.Coins) {
+				t.Errorf("split value = %d, want %d", PurseValue(total), PurseValue(tt.loot.Coins))
+			}
+			if !reflect.DeepEqual(got.Skipped, tt.wantSkipped) {
+				t.Errorf("Skipped = %v, want %v", got.Skipped, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestPlanLootSplitItems(t *testing.T) {
+	loot := models.Loot{Items: []models.Equipment{
+		{ID: "torch", Name: "Torch", Quantity: 5, Equipped: true},
+		{ID: "sword", Name: "Sword", Quantity: 1},
+	}}
+
+	got, err := PlanLootSplit("party", lootMembers()[:2], loot, models.LootStrategy{RemainderTo: "b"})
+	if err != nil {
+		t.Fatalf("PlanLootSplit returned error: %v", err)
+	}
+
+	for i, recipient := range got.Recipients {
+		if len(recipient.Items) != 1 {
+			t.Fatalf("recipient %d items = %+v, want one torch stack", i, recipient.Items)
+		}
+		item := recipient.Items[0]
+		if item.Name != "Torch" || item.Quantity != 2 || item.Equipped || !item.IsActive {
+			t.Errorf("recipient %d item = %+v, want 2 unequipped torches", i, item)
+		}
+	}
+	if got.Recipients[0].Items[0].ID == got.Recipients[1].Items[0].ID {
+		t.Errorf("recipients share item ID %q", got.Recipients[0].Items[0].ID)
+	}
+
+	if got.Remainder.CharacterID != "b" {
+		t.Errorf("remainder CharacterID = %q, want %q", got.Remainder.CharacterID, "b")
+	}
+
+	var remainder []string
+	for _, item := range got.Remainder.Items {
+		remainder = append(remainder, item.ID)
+	}
+	if want := []string{"torch-r", "sword"}; !reflect.DeepEqual(remainder, want) {
+		t.Errorf("remainder item IDs = %v, want %v", remainder, want)
+	}
+	if got.Remainder.Items[0].Quantity != 1 {
+		t.Errorf("remainder torches = %d, want 1", got.Remainder.Items[0].Quantity)
+	}
+}
+
+func TestPlanLootSplitRejects(t *testing.T) {
+	retired := lootMembers()
+	for _, member := range retired {
+		member.IsActive = false
+	}
+
+	tests := []struct {
+		name     string
+		members  []*models.Character
+		loot     models.Loot
+		strategy models.LootStrategy
+	}{
+		{"negative coins", lootMembers(), models.Loot{Coins: models.Purse{GP: -1}}, models.LootStrategy{}},
+		{"item without quantity", lootMembers(), models.Loot{Items: []models.Equipment{{Name: "Torch"}}}, models.LootStrategy{}},
+		{"unknown method", lootMembers(), models.Loot{}, models.LootStrategy{Method: "random"}},
+		{"negative shares", lootMembers(), models.Loot{}, models.LootStrategy{Method: models.LootSplitShares, Shares: map[string]int{"a": -1}}},
+		{"remainder outside the party", lootMembers(), models.Loot{}, models.LootStrategy{RemainderTo: "z"}},
+		{"no one eligible", retired, models.Loot{Coins: models.Purse{GP: 1}}, models.LootStrategy{}},
+	}
+
+	for _, tt := range tests {
+		if got, err := PlanLootSplit("party", tt.members, tt.loot, tt.strategy); err == nil {
+			t.Errorf("PlanLootSplit(%s) = %+v, want error", tt.name, got)
+		}
+	}
+}
+
+func addPurse(a, b models.Purse) models.Purse {
+	return models.Purse{PP: a.PP + b.PP, EP: a.EP + b.EP, GP: a.GP + b.GP, SP: a.SP + b.SP, CP: a.CP + b.CP}
+}
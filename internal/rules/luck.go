@@ -0,0 +1,145 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// thiefLuckDice is the thief luck die by level, indexed from level 1
+var thiefLuckDice = []int{3, 4, 5, 6, 7, 8, 10, 12, 14, 16}
+
+// ClassLevel returns a character's level in a class, falling back to
+// Character.Level when the class is only recorded in Character.Class
+func ClassLevel(character *models.Character, name string) int {
+	for _, class := range character.Classes {
+		if class.IsActive && strings.EqualFold(strings.TrimSpace(class.Name), name) {
+			return class.Level
+		}
+	}
+	if strings.EqualFold(strings.TrimSpace(character.Class), name) {
+		return character.Level
+	}
+	return 0
+}
+
+// RegeneratesLuck reports whether a character's burned Luck comes back with rest
+func RegeneratesLuck(character *models.Character) bool {
+	return HasClass(character, "Thief") || HasClass(character, "Halfling")
+}
+
+// ThiefLuckDie returns the luck die a thief rolls per point of burned Luck, or 0 for non-thieves
+func ThiefLuckDie(character *models.Character) int {
+	if !HasClass(character, "Thief") {
+		return 0
+	}
+
+	level := ClassLevel(character, "Thief")
+	if level < 1 {
+		level = 1
+	}
+	if level > len(thiefLuckDice) {
+		level = len(thiefLuckDice)
+	}
+	return thiefLuckDice[level-1]
+}
+
+// BurnLuck permanently spends Luck for a bonus. Each point adds +1, +2 for
+// halflings, or a roll of the luck die for thieves. Thieves and halflings can
+// regenerate the burn. Luck cannot be burned below the minimum score of 1.
+func BurnLuck(roller *dice.Roller, character *models.Character, amount int, reason string) (*models.LuckBurnResult, error) {
+	if amount < 1 {
+		return nil, fmt.Errorf("must burn at least 1 point of Luck")
+	}
+
+	available := CurrentScore(character.Luck)
+	if character.Luck.Base < available {
+		available = character.Luck.Base
+	}
+	available -= minAttributeScore
+	if available < 0 {
+		available = 0
+	}
+	if amount > available {
+		return nil, fmt.Errorf("cannot burn %d Luck, only %d can be burned", amount, available)
+	}
+
+	result := &models.LuckBurnResult{
+		Character: character,
+		Amount:    amount,
+	}
+
+	if die := ThiefLuckDie(character); die > 0 {
+		result.LuckDie = fmt.Sprintf("1d%d", die)
+		for i := 0; i < amount; i++ {
+			roll := roller.RollDie(die)
+			result.Rolls = append(result.Rolls, roll)
+			result.Bonus += roll
+		}
+	} else if HasClass(character, "Halfling") {
+		result.Bonus = 2 * amount
+	} else {
+		result.Bonus = amount
+	}
+
+	before := character.Luck.Base
+	character.Luck.Base -= amount
+	if character.Luck.Temporary != 0 {
+		character.Luck.Temporary -= amount
+	}
+	if RegeneratesLuck(character) {
+		character.LuckBurned += amount
+	}
+
+	recordLuckEvent(character, models.LuckEventBurn, amount, result.Bonus, before, reason)
+	return result, nil
+}
+
+// RegenerateLuck restores a night's worth of burned Luck for thieves and
+// halflings: one point per class level, up to the amount burned. Returns the
+// number of points regained.
+func RegenerateLuck(character *models.Character) (int, error) {
+	if !RegeneratesLuck(character) {
+		return 0, fmt.Errorf("%s does not regenerate Luck", character.Name)
+	}
+
+	level := ClassLevel(character, "Thief")
+	if halfling := ClassLevel(character, "Halfling"); halfling > level {
+		level = halfling
+	}
+	if level < 1 {
+		level = 1
+	}
+
+	amount := level
+	if amount > character.LuckBurned {
+		amount = character.LuckBurned
+	}
+	if amount == 0 {
+		return 0, nil
+	}
+
+	before := character.Luck.Base
+	character.Luck.Base += amount
+	character.LuckBurned -= amount
+
+	recordLuckEvent(character, models.LuckEventRegenerate, amount, 0, before, "Nightly Luck regeneration")
+	return amount, nil
+}
+
+func recordLuckEvent(character *models.Character, eventType string, amount int, bonus int, before int, reason string) {
+	now := time.Now()
+	character.LuckLog = append(character.LuckLog, models.LuckEvent{
+		Timestamp:  now,
+		Session:    models.SessionKey(now),
+		Type:       eventType,
+		Amount:     amount,
+		Bonus:      bonus,
+		LuckBefore: before,
+		LuckAfter:  character.Luck.Base,
+		Reason:     reason,
+	})
+}
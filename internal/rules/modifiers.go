@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// AbilityModifier returns the DCC modifier for an ability score.
+// Matches getDCCModifier in frontend/src/utils/calculations.js.
+func AbilityModifier(score int) int {
+	switch {
+	case score <= 3:
+		return -3
+	case score <= 5:
+		return -2
+	case score <= 8:
+		return -1
+	case score <= 12:
+		return 0
+	case score <= 15:
+		return 1
+	case score <= 17:
+		return 2
+	case score <= 19:
+		return 3
+	case score <= 21:
+		return 4
+	case score <= 23:
+		return 5
+	default:
+		return 6
+	}
+}
+
+// AttributeModifier returns the modifier for an attribute. Like the frontend,
+// modifiers are always calculated from the base score.
+func AttributeModifier(attr models.Attribute) int {
+	return AbilityModifier(attr.Base)
+}
+
+// CurrentScore returns an attribute's current score: its temporary score if
+// one is set, otherwise its base score
+func CurrentScore(attr models.Attribute) int {
+	if attr.Temporary != 0 {
+		return attr.Temporary
+	}
+	return attr.Base
+}
+
+// HasClass reports whether a character belongs to a class, either through
+// Character.Class or an active entry in Character.Classes
+func HasClass(character *models.Character, name string) bool {
+	if strings.EqualFold(strings.TrimSpace(character.Class), name) {
+		return true
+	}
+	for _, class := range character.Classes {
+		if class.IsActive && strings.EqualFold(strings.TrimSpace(class.Name), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ActionDie returns the sides of a character's primary action die, d20 by default
+func ActionDie(character *models.Character) int {
+	actionDice, err := dice.ParseActionDice(character.ActionDice)
+	if err != nil || len(actionDice) == 0 {
+		return 20
+	}
+	return actionDice[0]
+}
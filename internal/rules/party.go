@@ -0,0 +1,234 @@
+package rules
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// SyncPartyMembers brings Party.Members in step with Party.CharacterIDs:
+// new IDs join as members and members whose IDs were removed become former
+// members who left. names maps character IDs to their current names.
+func SyncPartyMembers(party *models.Party, names map[string]string, now time.Time) {
+	listed := make(map[string]bool)
+	for _, id := range party.CharacterIDs {
+		listed[id] = true
+	}
+
+	var members []models.PartyMember
+	present := make(map[string]bool)
+	for _, member := range party.Members {
+		if !listed[member.CharacterID] {
+			party.FormerMembers = append(party.FormerMembers, models.FormerMember{
+				PartyMember: member,
+				LeftAt:      now,
+				Reason:      models.PartyLeftLeft,
+			})
+			continue
+		}
+		if name, ok := names[member.CharacterID]; ok {
+			member.CharacterName = name
+		}
+		members = append(members, member)
+		present[member.CharacterID] = true
+	}
+
+	for _, id := range party.CharacterIDs {
+		if present[id] {
+			continue
+		}
+		members = append(members, models.PartyMember{
+			CharacterID:   id,
+			CharacterName: names[id],
+			JoinedAt:      now,
+		})
+		present[id] = true
+	}
+
+	party.Members = members
+	compactMarchingOrder(party)
+}
+
+// RemovePartyMember moves a member to the party's former members with a reason
+func RemovePartyMember(party *models.Party, characterId string, reason string, now time.Time) error {
+	if reason == "" {
+		reason = models.PartyLeftLeft
+	}
+
+	for i, member := range party.Members {
+		if member.CharacterID != characterId {
+			continue
+		}
+
+		party.Members = append(party.Members[:i], party.Members[i+1:]...)
+		member.Position = 0
+		party.FormerMembers = append(party.FormerMembers, models.FormerMember{
+			PartyMember: member,
+			LeftAt:      now,
+			Reason:      reason,
+		})
+
+		ids := party.CharacterIDs[:0]
+		for _, id := range party.CharacterIDs {
+			if id != characterId {
+				ids = append(ids, id)
+			}
+		}
+		party.CharacterIDs = ids
+
+		compactMarchingOrder(party)
+		return nil
+	}
+
+	return fmt.Errorf("character %s is not a member of %s", characterId, party.Name)
+}
+
+// SetMarchingOrder places members in marching order, front first. Members
+// left out are taken out of the marching order.
+func SetMarchingOrder(party *models.Party, characterIds []string) error {
+	positions := make(map[string]int)
+	for i, id := range characterIds {
+		if _, duplicate := positions[id]; duplicate {
+			return fmt.Errorf("character %s appears twice in the marching order", id)
+		}
+		positions[id] = i + 1
+	}
+
+	found := 0
+	for _, member := range party.Members {
+		if _, ok := positions[member.CharacterID]; ok {
+			found++
+		}
+	}
+	if found != len(characterIds) {
+		return fmt.Errorf("the marching order includes characters who are not members of %s", party.Name)
+	}
+
+	for i := range party.Members {
+		party.Members[i].Position = positions[party.Members[i].CharacterID]
+	}
+
+	compactMarchingOrder(party)
+	return nil
+}
+
+// SetMemberRole sets a member's role, e.g. torchbearer or caller
+func SetMemberRole(party *models.Party, characterId string, role string) error {
+	for i := range party.Members {
+		if party.Members[i].CharacterID == characterId {
+			party.Members[i].Role = role
+			return nil
+		}
+	}
+	return fmt.Errorf("character %s is not a member of %s", characterId, party.Name)
+}
+
+// MarchingOrder returns the members with a marching position, front first
+func MarchingOrder(party *models.Party) []models.PartyMember {
+	order := []models.PartyMember{}
+	for _, member := range party.Members {
+		if member.Position > 0 {
+			order = append(order, member)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return order[i].Position < order[j].Position
+	})
+	return order
+}
+
+// compactMarchingOrder renumbers marching positions from 1 with no gaps
+func compactMarchingOrder(party *models.Party) {
+	order := MarchingOrder(party)
+	positions := make(map[string]int)
+	for i, member := range order {
+		positions[member.CharacterID] = i + 1
+	}
+	for i := range party.Members {
+		party.Members[i].Position = positions[party.Members[i].CharacterID]
+	}
+}
+
+// PartySummary aggregates a party's active members. Health, armor class,
+// level and saves cover the living; wealth and carried weight cover everyone,
+// since the dead's belongings are still the party's to share.
+func PartySummary(cat *catalog.Catalog, party *models.Party, members []*models.Character) *models.PartySummary {
+	summary := &models.PartySummary{
+		PartyID:   party.ID,
+		PartyName: party.Name,
+		Wounded:   []models.PartyMemberStatus{},
+		Dying:     []models.PartyMemberStatus{},
+		Dead:      []models.PartyMemberStatus{},
+	}
+	stash := Wealth(&models.Character{Purse: party.StashPurse, Equipment: party.Stash})
+	summary.StashWealth = stash.TotalValue
+	summary.TotalWealth = summary.StashWealth
+
+	totalArmorClass := 0
+	bestSet := false
+	for _, character := range members {
+		if !character.IsActive {
+			continue
+		}
+		summary.MemberCount++
+		summary.TotalWealth += Wealth(character).TotalValue
+		carried, _ := CarriedWeight(character)
+		summary.CarriedWeight += carried
+
+		status := models.PartyMemberStatus{
+			CharacterID:     character.ID,
+			CharacterName:   character.Name,
+			CurrentHealth:   character.CurrentHealth,
+			MaxHealth:       character.MaxHealth,
+			LifeState:       LifeState(character),
+			RoundsRemaining: character.Life.RoundsRemaining,
+		}
+
+		switch LifeState(character) {
+		case models.LifeStateDying:
+			summary.Dying = append(summary.Dying, status)
+			continue
+		case models.LifeStateDead:
+			summary.Dead = append(summary.Dead, status)
+			continue
+		}
+
+		if character.CurrentHealth < character.MaxHealth {
+			summary.Wounded = append(summary.Wounded, status)
+		}
+
+		derived := DerivedStats(cat, character)
+		summary.LivingCount++
+		summary.CurrentHealth += character.CurrentHealth
+		summary.MaxHealth += character.MaxHealth
+		summary.TotalLevel += character.Level
+		totalArmorClass += derived.ArmorClass
+		if summary.LivingCount == 1 || derived.ArmorClass < summary.LowestArmorClass {
+			summary.LowestArmorClass = derived.ArmorClass
+		}
+
+		leader := func(bonus int) models.SaveLeader {
+			return models.SaveLeader{CharacterID: character.ID, CharacterName: character.Name, Bonus: bonus}
+		}
+		if !bestSet || derived.Saves.Reflex > summary.BestSaves.Reflex.Bonus {
+			summary.BestSaves.Reflex = leader(derived.Saves.Reflex)
+		}
+		if !bestSet || derived.Saves.Fortitude > summary.BestSaves.Fortitude.Bonus {
+			summary.BestSaves.Fortitude = leader(derived.Saves.Fortitude)
+		}
+		if !bestSet || derived.Saves.Willpower > summary.BestSaves.Willpower.Bonus {
+			summary.BestSaves.Willpower = leader(derived.Saves.Willpower)
+		}
+		bestSet = true
+	}
+
+	if summary.LivingCount > 0 {
+		summary.AverageArmorClass = float64(totalArmorClass) / float64(summary.LivingCount)
+		summary.AverageLevel = float64(summary.TotalLevel) / float64(summary.LivingCount)
+	}
+
+	return summary
+}
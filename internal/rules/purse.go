@@ -0,0 +1,158 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Coin values in copper pieces
+const (
+	cpPerSP = 10
+	cpPerEP = 50
+	cpPerGP = 100
+	cpPerPP = 1000
+)
+
+// denomination is one kind of coin in a purse
+type denomination struct {
+	name  string
+	value int // In copper pieces
+	count func(p *models.Purse) *int
+}
+
+// denominations lists coins from least to most valuable
+var denominations = []denomination{
+	{"cp", 1, func(p *models.Purse) *int { return &p.CP }},
+	{"sp", cpPerSP, func(p *models.Purse) *int { return &p.SP }},
+	{"ep", cpPerEP, func(p *models.Purse) *int { return &p.EP }},
+	{"gp", cpPerGP, func(p *models.Purse) *int { return &p.GP }},
+	{"pp", cpPerPP, func(p *models.Purse) *int { return &p.PP }},
+}
+
+// PurseValue returns the value of a purse in copper pieces
+func PurseValue(purse models.Purse) int {
+	total := 0
+	for _, d := range denominations {
+		total += *d.count(&purse) * d.value
+	}
+	return total
+}
+
+// AdjustPurse adds a delta to a purse. Positive amounts are added as given.
+// When spending more of a coin than the purse holds, the shortfall is paid
+// from other coins, smallest first, breaking a larger coin and taking change
+// in gold, silver and copper if needed.
+func AdjustPurse(purse *models.Purse, delta models.Purse) error {
+	adjusted := *purse
+	shortfall := 0
+
+	for _, d := range denominations {
+		count := d.count(&adjusted)
+		amount := *d.count(&delta)
+		if amount >= 0 || *count >= -amount {
+			*count += amount
+			continue
+		}
+
+		shortfall += (-amount - *count) * d.value
+		*count = 0
+	}
+
+	if shortfall > 0 {
+		if err := spendCopper(&adjusted, shortfall); err != nil {
+			return err
+		}
+	}
+
+	*purse = adjusted
+	return nil
+}
+
+// spendCopper pays an amount in copper pieces from whatever coins are available
+func spendCopper(purse *models.Purse, cost int) error {
+	if available := PurseValue(*purse); available < cost {
+		return fmt.Errorf("not enough money: need %s, have %s", FormatCopper(cost), FormatCopper(available))
+	}
+
+	for _, d := range denominations {
+		count := d.count(purse)
+		use := cost / d.value
+		if use > *count {
+			use = *count
+		}
+		*count -= use
+		cost -= use * d.value
+	}
+
+	if cost == 0 {
+		return nil
+	}
+
+	// Break the smallest coin left; it is worth more than what is still owed
+	for _, d := range denominations {
+		count := d.count(purse)
+		if *count == 0 {
+			continue
+		}
+		*count--
+		makeChange(purse, d.value-cost)
+		return nil
+	}
+
+	return fmt.Errorf("not enough money")
+}
+
+// makeChange adds an amount in copper pieces as gold, silver and copper
+func makeChange(purse *models.Purse, amount int) {
+	purse.GP += amount / cpPerGP
+	amount %= cpPerGP
+	purse.SP += amount / cpPerSP
+	purse.CP += amount % cpPerSP
+}
+
+// FormatPurse describes a purse's coins, e.g. "3 gp, 5 sp"
+func FormatPurse(purse models.Purse) string {
+	var parts []string
+	for i := len(denominations) - 1; i >= 0; i-- {
+		d := denominations[i]
+		if count := *d.count(&purse); count != 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", count, d.name))
+		}
+	}
+	if len(parts) == 0 {
+		return "nothing"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatCopper describes an amount in copper pieces in gold, silver and copper
+func FormatCopper(amount int) string {
+	var purse models.Purse
+	makeChange(&purse, amount)
+	return FormatPurse(purse)
+}
+
+// Wealth totals a character's coins and the value of their active equipment
+func Wealth(character *models.Character) models.Wealth {
+	wealth := models.Wealth{
+		CharacterID: character.ID,
+		Purse:       character.Purse,
+		CoinValue:   float64(PurseValue(character.Purse)) / cpPerGP,
+	}
+
+	for _, item := range character.Equipment {
+		if !item.IsActive {
+			continue
+		}
+		quantity := item.Quantity
+		if quantity < 1 {
+			quantity = 1
+		}
+		wealth.ItemValue += float64(quantity) * item.Value
+	}
+
+	wealth.TotalValue = wealth.CoinValue + wealth.ItemValue
+	return wealth
+}
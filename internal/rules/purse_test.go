@@ -0,0 +1,131 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+func TestAdjustPurse(t *testing.T) {
+	tests := []struct {
+		name  string
+		purse models.Purse
+		delta models.Purse
+		want  models.Purse
+	}{
+		{
+			name:  "adding coins",
+			purse: models.Purse{GP: 1},
+			delta: models.Purse{GP: 2, CP: 5},
+			want:  models.Purse{GP: 3, CP: 5},
+		},
+		{
+			name:  "spending coins on hand",
+			purse: models.Purse{GP: 2, SP: 5},
+			delta: models.Purse{GP: -1},
+			want:  models.Purse{GP: 1, SP: 5},
+		},
+		{
+			name:  "paying gold in silver",
+			purse: models.Purse{SP: 20},
+			delta: models.Purse{GP: -1},
+			want:  models.Purse{SP: 10},
+		},
+		{
+			name:  "paying gold in electrum",
+			purse: models.Purse{EP: 3},
+			delta: models.Purse{GP: -1},
+			want:  models.Purse{EP: 1},
+		},
+		{
+			name:  "breaking gold for copper",
+			purse: models.Purse{GP: 1},
+			delta: models.Purse{CP: -3},
+			want:  models.Purse{SP: 9, CP: 7},
+		},
+		{
+			name:  "short on copper uses up the copper first",
+			purse: models.Purse{GP: 3, CP: 5},
+			delta: models.Purse{CP: -15},
+			want:  models.Purse{GP: 2, SP: 9},
+		},
+		{
+			name:  "breaking platinum takes change in gold and silver",
+			purse: models.Purse{PP: 1},
+			delta: models.Purse{GP: -1, SP: -5},
+			want:  models.Purse{GP: 8, SP: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			purse := tt.purse
+			if err := AdjustPurse(&purse, tt.delta); err != nil {
+				t.Fatalf("AdjustPurse returned error: %v", err)
+			}
+			if purse != tt.want {
+				t.Errorf("AdjustPurse = %+v, want %+v", purse, tt.want)
+			}
+			if got, want := PurseValue(purse), PurseValue(tt.purse)+PurseValue(tt.delta); got != want {
+				t.Errorf("PurseValue after = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestAdjustPurseRejects(t *testing.T) {
+	tests := []struct {
+		purse models.Purse
+		delta models.Purse
+	}{
+		{models.Purse{}, models.Purse{CP: -1}},
+		{models.Purse{SP: 5}, models.Purse{GP: -1}},
+		{models.Purse{EP: 1, SP: 3}, models.Purse{GP: -1}},
+		{models.Purse{PP: 1}, models.Purse{PP: -1, CP: -1}},
+	}
+
+	for _, tt := range tests {
+		purse := tt.purse
+		if err := AdjustPurse(&purse, tt.delta); err == nil {
+			t.Errorf("AdjustPurse(%+v, %+v) = %+v, want error", tt.purse, tt.delta, purse)
+		}
+		if purse != tt.purse {
+			t.Errorf("AdjustPurse(%+v, %+v) changed the purse to %+v", tt.purse, tt.delta, purse)
+		}
+	}
+}
+
+func TestPurseValue(t *testing.T) {
+	tests := []struct {
+		purse models.Purse
+		want  int
+	}{
+		{models.Purse{}, 0},
+		{models.Purse{CP: 7}, 7},
+		{models.Purse{PP: 1, EP: 1, GP: 1, SP: 1, CP: 1}, 1161},
+	}
+
+	for _, tt := range tests {
+		if got := PurseValue(tt.purse); got != tt.want {
+			t.Errorf("PurseValue(%+v) = %d, want %d", tt.purse, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCopper(t *testing.T) {
+	tests := []struct {
+		amount int
+		want   string
+	}{
+		{0, "nothing"},
+		{7, "7 cp"},
+		{123, "1 gp, 2 sp, 3 cp"},
+		{1050, "10 gp, 5 sp"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatCopper(tt.amount); got != tt.want {
+			t.Errorf("FormatCopper(%d) = %q, want %q", tt.amount, got, tt.want)
+		}
+	}
+}
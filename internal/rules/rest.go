@@ -0,0 +1,188 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Rounds per larger unit of game time. A round is about 10 seconds and a turn is 10 minutes.
+const (
+	roundsPerTurn = 60
+	roundsPerHour = 6 * roundsPerTurn
+	roundsPerDay  = 24 * roundsPerHour
+
+	// roundsPerNight is the rest that counts as a night's sleep
+	roundsPerNight = 8 * roundsPerHour
+)
+
+// DurationInRounds converts a game duration to rounds
+func DurationInRounds(duration models.GameDuration) (int, error) {
+	switch duration.Unit {
+	case models.TimeUnitRounds:
+		return duration.Value, nil
+	case models.TimeUnitTurns:
+		return duration.Value * roundsPerTurn, nil
+	case models.TimeUnitHours:
+		return duration.Value * roundsPerHour, nil
+	case models.TimeUnitDays:
+		return duration.Value * roundsPerDay, nil
+	default:
+		return 0, fmt.Errorf("unknown time unit '%s'", duration.Unit)
+	}
+}
+
+// DurationInDays converts a game duration to days of rest. Each full day
+// counts, and so does a remainder of at least a night's sleep (8 hours), so
+// an 8 hour rest heals like a night.
+func DurationInDays(duration models.GameDuration) (int, error) {
+	rounds, err := DurationInRounds(duration)
+	if err != nil {
+		return 0, err
+	}
+
+	days := rounds / roundsPerDay
+	if rounds%roundsPerDay >= roundsPerNight {
+		days++
+	}
+	return days, nil
+}
+
+// ValidRestQuality reports whether a rest quality is known
+func ValidRestQuality(quality string) bool {
+	return quality == models.RestNone || quality == models.RestNormal || quality == models.RestBedRest
+}
+
+// Rest applies a number of days of rest to a character: hit points heal at
+// 1 per level per day (2 with bed rest, 0-level characters count as level 1),
+// spellburn and other temporary attribute damage heal 1 point per day, lost
+// spells are restored, per-day class features reset and thieves and halflings
+// regenerate Luck. Dying and dead characters do not heal.
+func Rest(character *models.Character, days int, quality string) models.RestResult {
+	result := models.RestResult{
+		CharacterID:   character.ID,
+		CharacterName: character.Name,
+		FeaturesReset: []string{},
+	}
+
+	if days < 1 || quality == models.RestNone || !IsAlive(character) {
+		return result
+	}
+
+	level := character.Level
+	if level < 1 {
+		level = 1
+	}
+	perDay := level
+	if quality == models.RestBedRest {
+		perDay = 2 * level
+	}
+
+	if character.CurrentHealth < character.MaxHealth {
+		healed := perDay * days
+		if healed > character.MaxHealth-character.CurrentHealth {
+			healed = character.MaxHealth - character.CurrentHealth
+		}
+		character.CurrentHealth += healed
+		result.HitPointsHealed = healed
+	}
+
+	result.SpellburnHealed = RecoverSpellburn(character, days)
+
+	for _, attr := range []struct {
+		attr      *models.Attribute
+		spellburn int
+	}{
+		{&character.Strength, character.Spellburn.Strength},
+		{&character.Agility, character.Spellburn.Agility},
+		{&character.Stamina, character.Spellburn.Stamina},
+		{&character.Personality, 0},
+		{&character.Intelligence, 0},
+	} {
+		result.AttributesHealed += healTemporaryDamage(attr.attr, attr.spellburn, days)
+	}
+
+	for i := range character.Abilities {
+		if character.Abilities[i].Lost {
+			character.Abilities[i].Lost = false
+			result.SpellsRestored++
+		}
+	}
+
+	result.FeaturesReset = RestClassFeatures(character)
+
+	if RegeneratesLuck(character) {
+		for day := 0; day < days && character.LuckBurned > 0; day++ {
+			regained, _ := RegenerateLuck(character)
+			result.LuckRegained += regained
+		}
+	}
+
+	return result
+}
+
+// RestChanged reports whether resting did anything for the character
+func RestChanged(result models.RestResult) bool {
+	return result.HitPointsHealed+result.SpellburnHealed+result.AttributesHealed+result.SpellsRestored+result.LuckRegained+len(result.ConditionsExpired)+len(result.FeaturesReset) > 0 || result.BledOut
+}
+
+// RestNote summarizes a rest for a history entry
+func RestNote(duration models.GameDuration, quality string, result models.RestResult) string {
+	note := fmt.Sprintf("%d %s passed", duration.Value, duration.Unit)
+	switch quality {
+	case models.RestNormal:
+		note += " (rest)"
+	case models.RestBedRest:
+		note += " (bed rest)"
+	}
+
+	if result.HitPointsHealed > 0 {
+		note += fmt.Sprintf(", healed %d HP", result.HitPointsHealed)
+	}
+	if result.SpellburnHealed > 0 {
+		note += fmt.Sprintf(", healed %d spellburn", result.SpellburnHealed)
+	}
+	if result.AttributesHealed > 0 {
+		note += fmt.Sprintf(", healed %d attribute damage", result.AttributesHealed)
+	}
+	if result.SpellsRestored > 0 {
+		note += fmt.Sprintf(", restored %d spell(s)", result.SpellsRestored)
+	}
+	if result.LuckRegained > 0 {
+		note += fmt.Sprintf(", regained %d Luck", result.LuckRegained)
+	}
+	for _, reset := range result.FeaturesReset {
+		note += ", " + reset
+	}
+	if result.BledOut {
+		note += ", bled out"
+	}
+	if len(result.ConditionsExpired) > 0 {
+		note += fmt.Sprintf(", %s wore off", strings.Join(result.ConditionsExpired, ", "))
+	}
+
+	return note
+}
+
+// healTemporaryDamage heals damage below an attribute's base score that is
+// not spellburn by 1 point per day
+func healTemporaryDamage(attr *models.Attribute, spellburn int, days int) int {
+	damage := attr.Base - CurrentScore(*attr) - spellburn
+	if damage <= 0 {
+		return 0
+	}
+
+	healed := days
+	if healed > damage {
+		healed = damage
+	}
+
+	current := CurrentScore(*attr) + healed
+	if current >= attr.Base {
+		attr.Temporary = 0
+	} else {
+		attr.Temporary = current
+	}
+	return healed
+}
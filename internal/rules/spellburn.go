@@ -0,0 +1,127 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// CanSpellburn reports whether a character's class can spellburn
+func CanSpellburn(character *models.Character) bool {
+	return HasClass(character, "Wizard") || HasClass(character, "Elf")
+}
+
+// Spellburn temporarily reduces Strength, Agility and Stamina for a bonus of
+// +1 per point to a spell check. Reduced scores are kept in the Temporary
+// fields and no score can be burned below 1.
+func Spellburn(character *models.Character, allocation models.SpellburnAllocation) (*models.SpellburnResult, error) {
+	if !CanSpellburn(character) {
+		return nil, fmt.Errorf("only wizards and elves can spellburn")
+	}
+
+	if allocation.Strength < 0 || allocation.Agility < 0 || allocation.Stamina < 0 {
+		return nil, fmt.Errorf("spellburn amounts cannot be negative")
+	}
+
+	total := allocation.Strength + allocation.Agility + allocation.Stamina
+	if total == 0 {
+		return nil, fmt.Errorf("must spellburn at least 1 point")
+	}
+
+	for _, burn := range []struct {
+		name   string
+		attr   models.Attribute
+		amount int
+	}{
+		{"Strength", character.Strength, allocation.Strength},
+		{"Agility", character.Agility, allocation.Agility},
+		{"Stamina", character.Stamina, allocation.Stamina},
+	} {
+		if CurrentScore(burn.attr)-burn.amount < 1 {
+			return nil, fmt.Errorf("cannot burn %d %s from a score of %d", burn.amount, burn.name, CurrentScore(burn.attr))
+		}
+	}
+
+	burnAttribute(&character.Strength, allocation.Strength)
+	burnAttribute(&character.Agility, allocation.Agility)
+	burnAttribute(&character.Stamina, allocation.Stamina)
+
+	character.Spellburn.Strength += allocation.Strength
+	character.Spellburn.Agility += allocation.Agility
+	character.Spellburn.Stamina += allocation.Stamina
+
+	recordSpellburn(character, -allocation.Strength, -allocation.Agility, -allocation.Stamina, allocation.Reason)
+
+	return &models.SpellburnResult{
+		Character: character,
+		Bonus:     total,
+	}, nil
+}
+
+// RecoverSpellburn heals outstanding spellburn by 1 point per attribute per
+// day of rest. Returns the total points healed.
+func RecoverSpellburn(character *models.Character, days int) int {
+	if days < 1 {
+		return 0
+	}
+
+	str := healAttribute(&character.Strength, &character.Spellburn.Strength, days)
+	agi := healAttribute(&character.Agility, &character.Spellburn.Agility, days)
+	sta := healAttribute(&character.Stamina, &character.Spellburn.Stamina, days)
+
+	if str+agi+sta > 0 {
+		recordSpellburn(character, str, agi, sta, fmt.Sprintf("Healed after %d day(s) of rest", days))
+	}
+
+	return str + agi + sta
+}
+
+// OutstandingSpellburn returns the spellburn a character has yet to heal
+func OutstandingSpellburn(character *models.Character) models.Spellburn {
+	burn := character.Spellburn
+	return models.Spellburn{
+		Strength: burn.Strength,
+		Agility:  burn.Agility,
+		Stamina:  burn.Stamina,
+		Total:    burn.Strength + burn.Agility + burn.Stamina,
+	}
+}
+
+func burnAttribute(attr *models.Attribute, amount int) {
+	if amount == 0 {
+		return
+	}
+	attr.Temporary = CurrentScore(*attr) - amount
+}
+
+// healAttribute gives back burned points, so a fully healed score returns to
+// its pre-burn value even when that was a temporary boost or penalty
+func healAttribute(attr *models.Attribute, outstanding *int, days int) int {
+	healed := days
+	if healed > *outstanding {
+		healed = *outstanding
+	}
+	if healed == 0 {
+		return 0
+	}
+
+	*outstanding -= healed
+	attr.Temporary = CurrentScore(*attr) + healed
+	if attr.Temporary == attr.Base {
+		attr.Temporary = 0
+	}
+	return healed
+}
+
+func recordSpellburn(character *models.Character, str, agi, sta int, reason string) {
+	now := time.Now()
+	character.Spellburn.Ledger = append(character.Spellburn.Ledger, models.SpellburnEntry{
+		Timestamp: now,
+		Session:   models.SessionKey(now),
+		Strength:  str,
+		Agility:   agi,
+		Stamina:   sta,
+		Reason:    reason,
+	})
+}
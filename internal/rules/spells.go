@@ -0,0 +1,130 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// FindSpell returns a character's active spell by ability ID
+func FindSpell(character *models.Character, abilityID string) (*models.Ability, error) {
+	for i := range character.Abilities {
+		ability := &character.Abilities[i]
+		if ability.ID != abilityID || !ability.IsActive {
+			continue
+		}
+		if ability.Type != "spell" {
+			return nil, fmt.Errorf("'%s' is not a spell", ability.Name)
+		}
+		return ability, nil
+	}
+	return nil, fmt.Errorf("spell '%s' not found", abilityID)
+}
+
+// SpellCheckDC returns the minimum spell check needed to cast a spell of a level
+func SpellCheckDC(spellLevel int) int {
+	if spellLevel < 1 {
+		spellLevel = 1
+	}
+	return 10 + 2*spellLevel
+}
+
+// SpellCheckModifier returns the total modifier to a character's spell check:
+// caster level, Intelligence (Personality for clerics), the spell's own bonus,
+// the Seventh son augur and, for arcane casters, armor check penalties.
+func SpellCheckModifier(cat *catalog.Catalog, character *models.Character, spell *models.Ability) int {
+	casterLevel := spell.CasterLevel
+	if casterLevel == 0 {
+		casterLevel = character.Level
+	}
+
+	modifier := casterLevel + spell.SpellCheckBonus + BirthAugurModifier(cat, character, "spell-checks")
+
+	if HasClass(character, "Cleric") {
+		modifier += AttributeModifier(character.Personality)
+	} else {
+		modifier += AttributeModifier(character.Intelligence)
+		modifier += ArmorCheckPenalty(cat, character)
+	}
+
+	return modifier
+}
+
+// CastSpell rolls a spell check with the character's action die and resolves
+// success, spell loss, misfire and corruption. The spell is marked lost on the
+// character when appropriate, and a cleric's failures widen their disapproval
+// range. resultsTable is optional.
+func CastSpell(roller *dice.Roller, cat *catalog.Catalog, character *models.Character, spell *models.Ability, resultsTable *models.Table) (*models.SpellCastResult, *dice.Result, error) {
+	if spell.Lost {
+		return nil, nil, fmt.Errorf("%s is lost and cannot be cast until it is restored", spell.Name)
+	}
+
+	modifier := SpellCheckModifier(cat, character, spell)
+	roll := roller.RollExpression(&dice.Expression{
+		Terms:    []dice.Term{{Count: 1, Sides: ActionDie(character)}},
+		Modifier: modifier,
+	})
+
+	result := &models.SpellCastResult{
+		CharacterID: character.ID,
+		AbilityID:   spell.ID,
+		SpellName:   spell.Name,
+		Expression:  roll.Expression,
+		Natural:     roll.Natural(),
+		Modifier:    modifier,
+		Total:       roll.Total,
+		DC:          SpellCheckDC(spell.SpellLevel),
+	}
+
+	cleric := HasClass(character, "Cleric")
+
+	switch {
+	case cleric && result.Natural <= DisapprovalRange(character):
+		character.ClassState.Disapproval = DisapprovalRange(character) + 1
+		result.Outcome = fmt.Sprintf("Natural %d: failure, deity disapproval", result.Natural)
+	case result.Natural == 1:
+		// Lost, failure and worse: 1d4 modified by Luck decides how much worse
+		result.Lost = true
+		worse := roller.RollDie(4) + AttributeModifier(character.Luck)
+		switch {
+		case worse <= 0:
+			result.Corruption = true
+			result.PatronTaint = true
+			result.Misfire = true
+			result.Outcome = "Natural 1: spell lost, corruption, patron taint and misfire"
+		case worse <= 2:
+			result.Corruption = true
+			result.Outcome = "Natural 1: spell lost and corruption"
+		case worse == 3:
+			result.PatronTaint = true
+			result.Outcome = "Natural 1: spell lost and patron taint (corruption if no patron)"
+		default:
+			result.Misfire = true
+			result.Outcome = "Natural 1: spell lost and misfire"
+		}
+	case result.Total < result.DC && cleric:
+		character.ClassState.Disapproval = DisapprovalRange(character) + 1
+		result.Outcome = "Failure, disapproval range increases"
+	case result.Total < result.DC:
+		result.Lost = true
+		result.Outcome = "Failure, spell lost"
+	default:
+		result.Success = true
+		result.Outcome = "Success"
+	}
+
+	if result.Lost {
+		spell.Lost = true
+	}
+
+	if resultsTable != nil {
+		entry, _, err := LookupTableEntry(resultsTable, result.Total)
+		if err == nil {
+			result.TableResult = entry.Result
+		}
+	}
+
+	return result, roll, nil
+}
@@ -0,0 +1,198 @@
+package rules
+
+import (
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// RollTable rolls a table's dice, applies the modifier, clamps the total to
+// the table's range and returns the matching entry. If the table has no dice
+// it is rolled with 1dN, where N is its highest entry.
+func RollTable(roller *dice.Roller, table *models.Table, modifier int) (*models.TableRollResult, *dice.Result, error) {
+	if len(table.Entries) == 0 {
+		return nil, nil, fmt.Errorf("table '%s' has no entries", table.Name)
+	}
+
+	_, high := tableRange(table.Entries)
+
+	expr := strings.TrimSpace(table.Dice)
+	if expr == "" {
+		expr = fmt.Sprintf("1d%d", high)
+	}
+
+	roll, err := roller.Roll(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entry, total, err := LookupTableEntry(table, roll.Total+modifier)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &models.TableRollResult{
+		TableID:    table.ID,
+		TableName:  table.Name,
+		Expression: roll.Expression,
+		Roll:       roll.Total,
+		Modifier:   modifier,
+		Total:      total,
+		Clamped:    total != roll.Total+modifier,
+		Entry:      *entry,
+	}, roll, nil
+}
+
+// LookupTableEntry clamps a result to a table's range and returns the
+// matching entry along with the clamped result
+func LookupTableEntry(table *models.Table, result int) (*models.TableEntry, int, error) {
+	if len(table.Entries) == 0 {
+		return nil, 0, fmt.Errorf("table '%s' has no entries", table.Name)
+	}
+
+	low, high := tableRange(table.Entries)
+	if result < low {
+		result = low
+	}
+	if result > high {
+		result = high
+	}
+
+	for i := range table.Entries {
+		if result >= table.Entries[i].Min && result <= table.Entries[i].Max {
+			return &table.Entries[i], result, nil
+		}
+	}
+
+	return nil, 0, fmt.Errorf("table '%s' has no entry for %d", table.Name, result)
+}
+
+func tableRange(entries []models.TableEntry) (int, int) {
+	low, high := entries[0].Min, entries[0].Max
+	for _, entry := range entries {
+		if entry.Min < low {
+			low = entry.Min
+		}
+		if entry.Max > high {
+			high = entry.Max
+		}
+	}
+	return low, high
+}
+
+// SortTableEntries orders entries from lowest to highest roll
+func SortTableEntries(entries []models.TableEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Min < entries[j].Min
+	})
+}
+
+// ValidateTableEntries checks that entry ranges neither overlap nor leave gaps,
+// so every roll between the lowest and highest entry finds a result. The
+// entries are not reordered.
+func ValidateTableEntries(entries []models.TableEntry) error {
+	sorted := append([]models.TableEntry{}, entries...)
+	SortTableEntries(sorted)
+
+	for i, entry := range sorted {
+		if entry.Min > entry.Max {
+			return fmt.Errorf("entry '%s' has an invalid range %d-%d", entry.Result, entry.Min, entry.Max)
+		}
+		if i == 0 {
+			continue
+		}
+		previous := sorted[i-1]
+		if entry.Min <= previous.Max {
+			return fmt.Errorf("entries '%s' and '%s' overlap", previous.Result, entry.Result)
+		}
+		if entry.Min > previous.Max+1 {
+			if entry.Min == previous.Max+2 {
+				return fmt.Errorf("no entry for %d, between '%s' and '%s'", previous.Max+1, previous.Result, entry.Result)
+			}
+			return fmt.Errorf("no entry for %d-%d, between '%s' and '%s'", previous.Max+1, entry.Min-1, previous.Result, entry.Result)
+		}
+	}
+
+	return nil
+}
+
+// ParseTableCSV parses table entries from CSV with a range column and a result
+// column, e.g. "1-3,Nothing happens". A header row is skipped if its range
+// column is not a number or range.
+func ParseTableCSV(data string) ([]models.TableEntry, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []models.TableEntry{}
+	for i, record := range records {
+		if len(record) == 0 || (len(record) == 1 && strings.TrimSpace(record[0]) == "") {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("line %d: expected a range and a result", i+1)
+		}
+
+		min, max, err := ParseRange(record[0])
+		if err != nil {
+			if i == 0 {
+				continue // Header row
+			}
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+
+		entries = append(entries, models.TableEntry{
+			Min:    min,
+			Max:    max,
+			Result: strings.TrimSpace(strings.Join(record[1:], ",")),
+		})
+	}
+
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("no table entries found")
+	}
+
+	if err := ValidateTableEntries(entries); err != nil {
+		return nil, err
+	}
+	SortTableEntries(entries)
+
+	return entries, nil
+}
+
+// ParseRange parses a roll range such as "4", "1-3" or "1–3"
+func ParseRange(s string) (int, int, error) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, "–", "-"))
+
+	// Skip a leading minus sign so negative values parse
+	start := 0
+	if strings.HasPrefix(s, "-") {
+		start = 1
+	}
+
+	if idx := strings.Index(s[start:], "-"); idx != -1 {
+		idx += start
+		low, errLow := strconv.Atoi(strings.TrimSpace(s[:idx]))
+		high, errHigh := strconv.Atoi(strings.TrimSpace(s[idx+1:]))
+		if errLow != nil || errHigh != nil {
+			return 0, 0, fmt.Errorf("invalid range '%s'", s)
+		}
+		return low, high, nil
+	}
+
+	value, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid range '%s'", s)
+	}
+	return value, value, nil
+}
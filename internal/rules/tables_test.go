@@ -0,0 +1,133 @@
+package rules
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+func TestValidateTableEntries(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []models.TableEntry
+	}{
+		{"single entry", []models.TableEntry{{Min: 1, Max: 6, Result: "All"}}},
+		{"contiguous", []models.TableEntry{{Min: 1, Max: 3, Result: "Low"}, {Min: 4, Max: 6, Result: "High"}}},
+		{"out of order", []models.TableEntry{{Min: 4, Max: 6, Result: "High"}, {Min: 1, Max: 3, Result: "Low"}}},
+		{"negative results", []models.TableEntry{{Min: -2, Max: 0, Result: "Fumble"}, {Min: 1, Max: 1, Result: "Miss"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := append([]models.TableEntry{}, tt.entries...)
+			if err := ValidateTableEntries(tt.entries); err != nil {
+				t.Fatalf("ValidateTableEntries returned error: %v", err)
+			}
+			if !reflect.DeepEqual(tt.entries, before) {
+				t.Errorf("ValidateTableEntries reordered entries to %+v", tt.entries)
+			}
+		})
+	}
+}
+
+func TestValidateTableEntriesRejects(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []models.TableEntry
+	}{
+		{"inverted range", []models.TableEntry{{Min: 3, Max: 1, Result: "Backwards"}}},
+		{"overlap", []models.TableEntry{{Min: 1, Max: 3, Result: "Low"}, {Min: 3, Max: 6, Result: "High"}}},
+		{"duplicate", []models.TableEntry{{Min: 1, Max: 1, Result: "One"}, {Min: 1, Max: 1, Result: "Also one"}}},
+		{"one-number gap", []models.TableEntry{{Min: 1, Max: 2, Result: "Low"}, {Min: 4, Max: 6, Result: "High"}}},
+		{"wide gap out of order", []models.TableEntry{{Min: 10, Max: 12, Result: "High"}, {Min: 1, Max: 3, Result: "Low"}}},
+	}
+
+	for _, tt := range tests {
+		if err := ValidateTableEntries(tt.entries); err == nil {
+			t.Errorf("ValidateTableEntries(%s) succeeded, want error", tt.name)
+		}
+	}
+}
+
+func TestSortTableEntries(t *testing.T) {
+	entries := []models.TableEntry{
+		{Min: 7, Max: 10, Result: "High"},
+		{Min: 1, Max: 3, Result: "Low"},
+		{Min: 4, Max: 6, Result: "Middle"},
+	}
+	want := []models.TableEntry{
+		{Min: 1, Max: 3, Result: "Low"},
+		{Min: 4, Max: 6, Result: "Middle"},
+		{Min: 7, Max: 10, Result: "High"},
+	}
+
+	SortTableEntries(entries)
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("SortTableEntries = %+v, want %+v", entries, want)
+	}
+}
+
+func TestParseTableCSV(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []models.TableEntry
+	}{
+		{
+			name: "header row and sorting",
+			data: "Roll,Result\n4-6,Treasure\n1-3,Nothing happens\n",
+			want: []models.TableEntry{
+				{Min: 1, Max: 3, Result: "Nothing happens"},
+				{Min: 4, Max: 6, Result: "Treasure"},
+			},
+		},
+		{
+			name: "single values, en dashes and commas in results",
+			data: "1,Trap\n2–3,\"Gold, lots\"\n4,Goblin, angry\n",
+			want: []models.TableEntry{
+				{Min: 1, Max: 1, Result: "Trap"},
+				{Min: 2, Max: 3, Result: "Gold, lots"},
+				{Min: 4, Max: 4, Result: "Goblin,angry"},
+			},
+		},
+		{
+			name: "blank lines and negative ranges",
+			data: "-1-0,Fumble\n\n1-2,Hit\n",
+			want: []models.TableEntry{
+				{Min: -1, Max: 0, Result: "Fumble"},
+				{Min: 1, Max: 2, Result: "Hit"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTableCSV(tt.data)
+			if err != nil {
+				t.Fatalf("ParseTableCSV returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTableCSV =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTableCSVRejects(t *testing.T) {
+	tests := []string{
+		"",
+		"Roll,Result\n",
+		"1-3\n",
+		"1-3,Low\nfour,High\n",
+		"1-3,Low\n3-6,High\n",
+		"1-2,Low\n4-6,High\n",
+		"1-3,\"Unclosed\n",
+	}
+
+	for _, data := range tests {
+		if got, err := ParseTableCSV(data); err == nil {
+			t.Errorf("ParseTableCSV(%q) = %+v, want error", data, got)
+		}
+	}
+}
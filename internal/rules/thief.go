@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// thiefModule gives thieves their skill table, scroll die and luck die
+type thiefModule struct{}
+
+func (thiefModule) Class() string {
+	return "Thief"
+}
+
+func (thiefModule) Describe(cat *catalog.Catalog, character *models.Character, features *models.ClassFeatures) {
+	if cat != nil {
+		for i := range cat.ThiefSkills {
+			features.ThiefSkills = append(features.ThiefSkills, thiefSkillBonus(character, &cat.ThiefSkills[i]))
+		}
+		if cat.ThiefScrollDice != nil {
+			features.ScrollDie = cat.ThiefScrollDice.Die(thiefLevel(character), character.Alignment)
+		}
+	}
+	if die := ThiefLuckDie(character); die > 0 {
+		features.LuckDie = fmt.Sprintf("d%d", die)
+	}
+}
+
+func (thiefModule) Rest(character *models.Character) string {
+	return ""
+}
+
+// thiefLevel returns a character's thief level, treating level 0 as level 1
+func thiefLevel(character *models.Character) int {
+	level := ClassLevel(character, "Thief")
+	if level < 1 {
+		level = 1
+	}
+	return level
+}
+
+// thiefSkillBonus totals a skill's table bonus and ability modifier
+func thiefSkillBonus(character *models.Character, skill *catalog.ThiefSkill) models.ThiefSkillBonus {
+	bonus := models.ThiefSkillBonus{
+		Name:    skill.Name,
+		Bonus:   skill.Bonus(thiefLevel(character), character.Alignment),
+		Ability: skill.Ability,
+	}
+
+	switch strings.ToLower(skill.Ability) {
+	case "strength":
+		bonus.AbilityModifier = AttributeModifier(character.Strength)
+	case "agility":
+		bonus.AbilityModifier = AttributeModifier(character.Agility)
+	case "stamina":
+		bonus.AbilityModifier = AttributeModifier(character.Stamina)
+	case "personality":
+		bonus.AbilityModifier = AttributeModifier(character.Personality)
+	case "intelligence":
+		bonus.AbilityModifier = AttributeModifier(character.Intelligence)
+	case "luck":
+		bonus.AbilityModifier = AttributeModifier(character.Luck)
+	}
+
+	bonus.Total = bonus.Bonus + bonus.AbilityModifier
+	return bonus
+}
+
+// ThiefSkillCheck rolls d20 plus the thief's skill bonus and ability modifier against a DC
+func ThiefSkillCheck(roller *dice.Roller, cat *catalog.Catalog, character *models.Character, skillName string, dc int) (*models.SkillCheckResult, *dice.Result, error) {
+	if !HasClass(character, "Thief") {
+		return nil, nil, fmt.Errorf("%s is not a thief", character.Name)
+	}
+
+	skill, err := cat.GetThiefSkill(skillName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bonus := thiefSkillBonus(character, skill)
+	roll := roller.RollExpression(&dice.Expression{
+		Terms:    []dice.Term{{Count: 1, Sides: 20}},
+		Modifier: bonus.Total,
+	})
+
+	return &models.SkillCheckResult{
+		CharacterID: character.ID,
+		Skill:       skill.Name,
+		Expression:  roll.Expression,
+		Natural:     roll.Natural(),
+		Modifier:    bonus.Total,
+		Total:       roll.Total,
+		DC:          dc,
+		Success:     roll.Total >= dc,
+	}, roll, nil
+}
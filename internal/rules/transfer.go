@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// TakeEquipment removes some or all of an active equipment stack. A quantity
+// of 0 or less takes the whole stack. The whole stack keeps its ID so the
+// item's identity follows it; a partial stack gets a new ID. Taken items are
+// unequipped.
+func TakeEquipment(items *[]models.Equipment, itemId string, quantity int) (models.Equipment, error) {
+	for i, item := range *items {
+		if item.ID != itemId || !item.IsActive {
+			continue
+		}
+
+		stack := item.Quantity
+		if stack < 1 {
+			stack = 1
+		}
+		if quantity <= 0 {
+			quantity = stack
+		}
+		if quantity > stack {
+			return models.Equipment{}, fmt.Errorf("only %d %s to move, not %d", stack, item.Name, quantity)
+		}
+
+		taken := item
+		taken.Equipped = false
+		taken.Quantity = stack
+		if quantity == stack {
+			*items = append((*items)[:i], (*items)[i+1:]...)
+			return taken, nil
+		}
+
+		(*items)[i].Quantity = stack - quantity
+		taken.ID = fmt.Sprintf("%s-%d", item.ID, time.Now().UnixNano())
+		taken.Quantity = quantity
+		return taken, nil
+	}
+
+	return models.Equipment{}, fmt.Errorf("equipment not found: %s", itemId)
+}
+
+// GiveEquipment adds a stack to a list of equipment. A stack with the same ID
+// that is still active is topped up; a deleted one is replaced.
+func GiveEquipment(items *[]models.Equipment, item models.Equipment) {
+	item.IsActive = true
+	for i := range *items {
+		existing := &(*items)[i]
+		if existing.ID != item.ID {
+			continue
+		}
+		if existing.IsActive {
+			existing.Quantity += item.Quantity
+		} else {
+			*existing = item
+		}
+		return
+	}
+	*items = append(*items, item)
+}
+
+// DescribeStack describes an amount of an item, e.g. "3 × Torch" or "Longsword"
+func DescribeStack(item models.Equipment) string {
+	if item.Quantity > 1 {
+		return fmt.Sprintf("%d × %s", item.Quantity, item.Name)
+	}
+	return item.Name
+}
@@ -0,0 +1,244 @@
+package rules
+
+import (
+	"fmt"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/catalog"
+	"github.com/austinkempa/dcc-character-sheet/internal/dice"
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Default limits used when the house rules don't set their own
+const (
+	DefaultMaxAttributeScore = 24
+	minAttributeScore        = 1
+)
+
+// validator collects issues, downgrading relaxed checks to warnings
+type validator struct {
+	result  *models.ValidationResult
+	relaxed map[string]bool
+}
+
+func (v *validator) add(severity, field, code, format string, args ...interface{}) {
+	issue := models.ValidationIssue{
+		Field:    field,
+		Code:     code,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+
+	if severity == models.SeverityError && v.relaxed[code] {
+		issue.Severity = models.SeverityWarning
+	}
+
+	if issue.Severity == models.SeverityError {
+		v.result.Errors = append(v.result.Errors, issue)
+	} else {
+		v.result.Warnings = append(v.result.Warnings, issue)
+	}
+}
+
+func (v *validator) errorf(field, code, format string, args ...interface{}) {
+	v.add(models.SeverityError, field, code, format, args...)
+}
+
+func (v *validator) warnf(field, code, format string, args ...interface{}) {
+	v.add(models.SeverityWarning, field, code, format, args...)
+}
+
+// ValidateCharacter checks a character against the rules. Errors are values
+// the rules don't allow, such as Current HP above Max or malformed dice;
+// warnings are legal but unusual. House rules can raise limits and relax
+// specific checks to warnings.
+func ValidateCharacter(cat *catalog.Catalog, character *models.Character, houseRules *models.HouseRules) *models.ValidationResult {
+	if houseRules == nil {
+		houseRules = &models.HouseRules{}
+	}
+
+	v := &validator{
+		result: &models.ValidationResult{
+			CharacterID: character.ID,
+			Errors:      []models.ValidationIssue{},
+			Warnings:    []models.ValidationIssue{},
+		},
+		relaxed: map[string]bool{},
+	}
+	for _, code := range houseRules.Relaxed {
+		v.relaxed[code] = true
+	}
+
+	maxLevel := MaxLevel
+	if houseRules.MaxLevel > 0 {
+		maxLevel = houseRules.MaxLevel
+	}
+	maxScore := DefaultMaxAttributeScore
+	if houseRules.MaxAttributeScore > 0 {
+		maxScore = houseRules.MaxAttributeScore
+	}
+
+	if character.Name == "" {
+		v.warnf("name", "missing-name", "Character has no name")
+	}
+
+	if character.Level < 0 {
+		v.errorf("level", "level-range", "Level cannot be negative (%d)", character.Level)
+	} else if character.Level > maxLevel {
+		v.errorf("level", "level-range", "Level %d is above the maximum of %d", character.Level, maxLevel)
+	}
+
+	if character.Alignment < 0 || character.Alignment > 2 {
+		v.errorf("alignment", "alignment", "Unknown alignment %d", character.Alignment)
+	}
+
+	validateHitPoints(v, character)
+	validateExperience(v, character)
+
+	for _, attr := range []struct {
+		field string
+		name  string
+		attr  models.Attribute
+	}{
+		{"strength", "Strength", character.Strength},
+		{"agility", "Agility", character.Agility},
+		{"stamina", "Stamina", character.Stamina},
+		{"personality", "Personality", character.Personality},
+		{"intelligence", "Intelligence", character.Intelligence},
+		{"luck", "Luck", character.Luck},
+	} {
+		validateAttribute(v, attr.field, attr.name, attr.attr, maxScore)
+	}
+
+	if character.LuckBurned < 0 {
+		v.errorf("luckBurned", "luck-burned", "Burned Luck cannot be negative (%d)", character.LuckBurned)
+	}
+
+	validateDice(v, character)
+	validateEquipment(v, character)
+	validateTables(v, character)
+
+	if character.CritTable != "" && cat != nil {
+		if _, err := cat.GetCritTable(character.CritTable); err != nil {
+			v.warnf("critTable", "unknown-crit-table", "Crit table '%s' is not in the catalog", character.CritTable)
+		}
+	}
+
+	switch character.Life.State {
+	case "", models.LifeStateAlive, models.LifeStateDying, models.LifeStateDead:
+	default:
+		v.errorf("life.state", "life-state", "Unknown life state '%s'", character.Life.State)
+	}
+
+	v.result.Valid = len(v.result.Errors) == 0
+	return v.result
+}
+
+func validateHitPoints(v *validator, character *models.Character) {
+	if character.MaxHealth < 0 {
+		v.errorf("maxHealth", "hp-range", "Max HP cannot be negative (%d)", character.MaxHealth)
+	} else if character.MaxHealth == 0 {
+		v.warnf("maxHealth", "hp-range", "Max HP is 0")
+	}
+
+	if character.CurrentHealth > character.MaxHealth {
+		v.errorf("currentHealth", "hp-above-max", "Current HP %d is above Max HP %d", character.CurrentHealth, character.MaxHealth)
+	}
+	if character.CurrentHealth < 0 {
+		v.errorf("currentHealth", "hp-range", "Current HP cannot be negative (%d)", character.CurrentHealth)
+	}
+	if character.CurrentHealth == 0 && IsAlive(character) && character.MaxHealth > 0 {
+		v.warnf("currentHealth", "hp-zero-alive", "Character is at 0 HP but not marked dying or dead")
+	}
+}
+
+func validateExperience(v *validator, character *models.Character) {
+	if character.TotalExperience < 0 {
+		v.errorf("totalExperience", "experience-range", "Total XP cannot be negative (%d)", character.TotalExperience)
+		return
+	}
+
+	if earned := LevelForExperience(character.TotalExperience); character.Level > earned {
+		v.warnf("level", "level-experience", "Level %d needs %d XP but the character has %d", character.Level, ExperienceForLevel(character.Level), character.TotalExperience)
+	}
+}
+
+func validateAttribute(v *validator, field, name string, attr models.Attribute, maxScore int) {
+	if attr.Base < minAttributeScore || attr.Base > maxScore {
+		v.errorf(field+".base", "attribute-range", "%s %d is outside %d-%d", name, attr.Base, minAttributeScore, maxScore)
+	} else if attr.Base < 3 || attr.Base > 18 {
+		v.warnf(field+".base", "attribute-unusual", "%s %d is outside the 3-18 rolled range", name, attr.Base)
+	}
+
+	if attr.Temporary < 0 || attr.Temporary > maxScore {
+		v.errorf(field+".temporary", "attribute-range", "Temporary %s %d is outside 0-%d", name, attr.Temporary, maxScore)
+	}
+}
+
+func validateDice(v *validator, character *models.Character) {
+	if character.ActionDice != "" {
+		if actionDice, err := dice.ParseActionDice(character.ActionDice); err != nil {
+			v.errorf("actionDice", "dice-format", "Action dice: %v", err)
+		} else {
+			for _, sides := range actionDice {
+				if dice.ChainIndex(sides) == -1 {
+					v.warnf("actionDice", "off-chain-die", "Action die d%d is not on the dice chain", sides)
+				}
+			}
+		}
+	}
+
+	if character.CritDice != "" {
+		if _, err := dice.Parse(character.CritDice); err != nil {
+			v.errorf("critDice", "dice-format", "Crit dice: %v", err)
+		}
+	}
+
+	for i, table := range character.Tables {
+		if !table.IsActive || table.Dice == "" {
+			continue
+		}
+		if _, err := dice.Parse(table.Dice); err != nil {
+			v.errorf(fmt.Sprintf("tables[%d].dice", i), "dice-format", "Table '%s' dice: %v", table.Name, err)
+		}
+	}
+}
+
+func validateEquipment(v *validator, character *models.Character) {
+	for i, item := range character.Equipment {
+		if !item.IsActive {
+			continue
+		}
+		field := fmt.Sprintf("equipment[%d]", i)
+
+		if item.Quantity < 0 {
+			v.errorf(field+".quantity", "equipment-range", "%s quantity cannot be negative (%d)", item.Name, item.Quantity)
+		}
+		if item.Weight < 0 {
+			v.errorf(field+".weight", "equipment-range", "%s weight cannot be negative", item.Name)
+		}
+		if item.Value < 0 {
+			v.errorf(field+".value", "equipment-range", "%s value cannot be negative", item.Name)
+		}
+		if item.DamageDice != "" {
+			if _, err := dice.Parse(item.DamageDice); err != nil {
+				v.errorf(field+".damageDice", "dice-format", "%s damage: %v", item.Name, err)
+			}
+		}
+		if item.FumbleDie != "" {
+			if _, err := dice.Parse(item.FumbleDie); err != nil {
+				v.errorf(field+".fumbleDie", "dice-format", "%s fumble die: %v", item.Name, err)
+			}
+		}
+	}
+}
+
+func validateTables(v *validator, character *models.Character) {
+	for i, table := range character.Tables {
+		if !table.IsActive {
+			continue
+		}
+		if err := ValidateTableEntries(table.Entries); err != nil {
+			v.errorf(fmt.Sprintf("tables[%d].entries", i), "table-range", "Table '%s': %v", table.Name, err)
+		}
+	}
+}
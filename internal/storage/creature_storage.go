@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Creature methods. Creatures are monster and NPC stat blocks shared by
+// world notes and encounters.
+
+func (s *Storage) GetCreature(id string) (*models.Creature, error) {
+	filename := filepath.Join(s.baseDir, creaturesDir, fmt.Sprintf("%s.json", id))
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var creature models.Creature
+	if err := json.Unmarshal(data, &creature); err != nil {
+		return nil, err
+	}
+
+	return &creature, nil
+}
+
+func (s *Storage) GetCreatures() ([]*models.Creature, error) {
+	return s.getCreaturesFiltered(true)
+}
+
+func (s *Storage) GetDeletedCreatures() ([]*models.Creature, error) {
+	return s.getCreaturesFiltered(false)
+}
+
+func (s *Storage) getCreaturesFiltered(active bool) ([]*models.Creature, error) {
+	dir := filepath.Join(s.baseDir, creaturesDir)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return []*models.Creature{}, nil
+	}
+
+	var creatures []*models.Creature
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			continue
+		}
+
+		var creature models.Creature
+		if err := json.Unmarshal(data, &creature); err != nil {
+			continue
+		}
+
+		if creature.IsActive == active {
+			creatures = append(creatures, &creature)
+		}
+	}
+
+	return creatures, nil
+}
+
+func (s *Storage) SaveCreature(creature *models.Creature) error {
+	filename := filepath.Join(s.baseDir, creaturesDir, fmt.Sprintf("%s.json", creature.ID))
+
+	data, err := json.MarshalIndent(creature, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0644)
+}
+
+func (s *Storage) DeleteCreature(id string) error {
+	creature, err := s.GetCreature(id)
+	if err != nil {
+		return err
+	}
+
+	creature.IsActive = false
+	return s.SaveCreature(creature)
+}
+
+func (s *Storage) RestoreCreature(id string) error {
+	creature, err := s.GetCreature(id)
+	if err != nil {
+		return err
+	}
+
+	creature.IsActive = true
+	return s.SaveCreature(creature)
+}
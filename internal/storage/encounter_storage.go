@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Encounter methods. Encounters are saved after every change so a fight
+// survives an app restart.
+
+func (s *Storage) GetEncounter(id string) (*models.Encounter, error) {
+	filename := filepath.Join(s.baseDir, encountersDir, fmt.Sprintf("%s.json", id))
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var encounter models.Encounter
+	if err := json.Unmarshal(data, &encounter); err != nil {
+		return nil, err
+	}
+
+	return &encounter, nil
+}
+
+func (s *Storage) GetEncounters() ([]*models.Encounter, error) {
+	return s.getEncountersFiltered(true)
+}
+
+func (s *Storage) GetDeletedEncounters() ([]*models.Encounter, error) {
+	return s.getEncountersFiltered(false)
+}
+
+func (s *Storage) getEncountersFiltered(active bool) ([]*models.Encounter, error) {
+	dir := filepath.Join(s.baseDir, encountersDir)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return []*models.Encounter{}, nil
+	}
+
+	var encounters []*models.Encounter
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			continue
+		}
+
+		var encounter models.Encounter
+		if err := json.Unmarshal(data, &encounter); err != nil {
+			continue
+		}
+
+		if encounter.IsActive == active {
+			encounters = append(encounters, &encounter)
+		}
+	}
+
+	return encounters, nil
+}
+
+func (s *Storage) SaveEncounter(encounter *models.Encounter) error {
+	filename := filepath.Join(s.baseDir, encountersDir, fmt.Sprintf("%s.json", encounter.ID))
+
+	data, err := json.MarshalIndent(encounter, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0644)
+}
+
+func (s *Storage) DeleteEncounter(id string) error {
+	encounter, err := s.GetEncounter(id)
+	if err != nil {
+		return err
+	}
+
+	encounter.IsActive = false
+	return s.SaveEncounter(encounter)
+}
+
+func (s *Storage) RestoreEncounter(id string) error {
+	encounter, err := s.GetEncounter(id)
+	if err != nil {
+		return err
+	}
+
+	encounter.IsActive = true
+	return s.SaveEncounter(encounter)
+}
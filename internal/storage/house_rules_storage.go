@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// House rules methods
+
+// GetHouseRules loads the house rules profile, returning the defaults if none has been saved
+func (s *Storage) GetHouseRules() (*models.HouseRules, error) {
+	data, err := os.ReadFile(filepath.Join(s.baseDir, houseRulesFile))
+	if os.IsNotExist(err) {
+		return &models.HouseRules{Relaxed: []string{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rules models.HouseRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	return &rules, nil
+}
+
+// SaveHouseRules writes the house rules profile
+func (s *Storage) SaveHouseRules(rules *models.HouseRules) error {
+	data, err := json.MarshalIndent(rules, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(s.baseDir, houseRulesFile), data, 0644)
+}
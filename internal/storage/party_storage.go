@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/austinkempa/dcc-character-sheet/internal/models"
 )
@@ -69,6 +70,26 @@ func (s *Storage) getPartiesFiltered(active bool) ([]*models.Party, error) {
 }
 
 func (s *Storage) SaveParty(party *models.Party) error {
+	return s.SavePartyWithNote(party, "")
+}
+
+// SavePartyWithNote saves a party, appending a history entry with the note
+// when anything changed since the last save
+func (s *Storage) SavePartyWithNote(party *models.Party, note string) error {
+	existing, err := s.GetParty(party.ID)
+	if err == nil {
+		changes := s.changeDetector.DetectPartyChanges(existing, party)
+		if len(changes) > 0 {
+			party.History = append(existing.History, models.HistoryEntry{
+				Timestamp: time.Now(),
+				Changes:   changes,
+				Note:      note,
+			})
+		} else {
+			party.History = existing.History
+		}
+	}
+
 	filename := filepath.Join(s.baseDir, partiesDir, fmt.Sprintf("%s.json", party.ID))
 
 	data, err := json.MarshalIndent(party, "", "  ")
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Roll log methods
+
+// GetRollLog returns a character's logged rolls, oldest first, narrowed by the filter
+func (s *Storage) GetRollLog(characterID string, filter models.RollLogFilter) ([]models.RollLogEntry, error) {
+	entries, err := s.readRollLog(characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterRollLog(entries, filter), nil
+}
+
+// AppendRollLog adds an entry to a character's roll log
+func (s *Storage) AppendRollLog(entry models.RollLogEntry) error {
+	entries, err := s.readRollLog(entry.CharacterID)
+	if err != nil {
+		return err
+	}
+
+	entries = append(entries, entry)
+	return s.writeRollLog(entry.CharacterID, entries)
+}
+
+// GetSessionRolls returns every logged roll for a session across all characters
+func (s *Storage) GetSessionRolls(session string) ([]models.RollLogEntry, error) {
+	dir := filepath.Join(s.baseDir, rollLogsDir)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return []models.RollLogEntry{}, nil
+	}
+
+	rolls := []models.RollLogEntry{}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		entries, err := s.readRollLog(strings.TrimSuffix(file.Name(), ".json"))
+		if err != nil {
+			continue
+		}
+
+		rolls = append(rolls, filterRollLog(entries, models.RollLogFilter{Session: session})...)
+	}
+
+	sort.Slice(rolls, func(i, j int) bool {
+		return rolls[i].Timestamp.Before(rolls[j].Timestamp)
+	})
+
+	return rolls, nil
+}
+
+func (s *Storage) readRollLog(characterID string) ([]models.RollLogEntry, error) {
+	filename := filepath.Join(s.baseDir, rollLogsDir, fmt.Sprintf("%s.json", characterID))
+
+	data, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return []models.RollLogEntry{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []models.RollLogEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (s *Storage) writeRollLog(characterID string, entries []models.RollLogEntry) error {
+	filename := filepath.Join(s.baseDir, rollLogsDir, fmt.Sprintf("%s.json", characterID))
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0644)
+}
+
+func filterRollLog(entries []models.RollLogEntry, filter models.RollLogFilter) []models.RollLogEntry {
+	filtered := []models.RollLogEntry{}
+	for _, entry := range entries {
+		if filter.Reason != "" && entry.Reason != filter.Reason {
+			continue
+		}
+		if filter.Session != "" && entry.Session != filter.Session {
+			continue
+		}
+		if !filter.Since.IsZero() && entry.Timestamp.Before(filter.Since) {
+			continue
+		}
+		if !filter.Until.IsZero() && entry.Timestamp.After(filter.Until) {
+			continue
+		}
+		filtered = append(filtered, entry)
+	}
+
+	if filter.Limit > 0 && len(filtered) > filter.Limit {
+		filtered = filtered[len(filtered)-filter.Limit:]
+	}
+
+	return filtered
+}
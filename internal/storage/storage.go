@@ -18,6 +18,13 @@ const (
 	worldNotesDir = "world-notes"
 	partiesDir    = "parties"
 	imagesDir     = "images"
+	rollLogsDir   = "roll-logs"
+	tablesDir     = "tables"
+	encountersDir = "encounters"
+	creaturesDir  = "creatures"
+
+	catalogOverrideFile = "catalog-override.json"
+	houseRulesFile      = "house-rules.json"
 )
 
 type Storage struct {
@@ -39,6 +46,10 @@ func NewStorage() *Storage {
 	os.MkdirAll(filepath.Join(baseDir, worldNotesDir), 0755)
 	os.MkdirAll(filepath.Join(baseDir, partiesDir), 0755)
 	os.MkdirAll(filepath.Join(baseDir, imagesDir), 0755)
+	os.MkdirAll(filepath.Join(baseDir, rollLogsDir), 0755)
+	os.MkdirAll(filepath.Join(baseDir, tablesDir), 0755)
+	os.MkdirAll(filepath.Join(baseDir, encountersDir), 0755)
+	os.MkdirAll(filepath.Join(baseDir, creaturesDir), 0755)
 
 	return &Storage{
 		baseDir:        baseDir,
@@ -46,6 +57,11 @@ func NewStorage() *Storage {
 	}
 }
 
+// CatalogOverridePath returns the location of the house rules catalog file
+func (s *Storage) CatalogOverridePath() string {
+	return filepath.Join(s.baseDir, catalogOverrideFile)
+}
+
 // Character methods
 
 func (s *Storage) GetCharacter(id string) (*models.Character, error) {
@@ -105,6 +121,12 @@ func (s *Storage) getCharactersFiltered(active bool) ([]*models.Character, error
 }
 
 func (s *Storage) SaveCharacter(character *models.Character, note string) error {
+	return s.SaveLinkedCharacter(character, note, nil)
+}
+
+// SaveLinkedCharacter saves a character like SaveCharacter, tagging the
+// history entry with a link to the other side of a transfer
+func (s *Storage) SaveLinkedCharacter(character *models.Character, note string, link *models.HistoryLink) error {
 	logFile := "/tmp/dcc-hp-save-log.txt"
 	f, _ := os.OpenFile(logFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 	if f != nil {
@@ -137,6 +159,7 @@ func (s *Storage) SaveCharacter(character *models.Character, note string) error
 				Timestamp: time.Now(),
 				Changes:   changes,
 				Note:      note,
+				Link:      link,
 			}
 			character.History = append(existingChar.History, historyEntry)
 			if f != nil {
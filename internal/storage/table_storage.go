@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/austinkempa/dcc-character-sheet/internal/models"
+)
+
+// Shared table methods. Shared tables live in a library outside any
+// character so they can be copied onto several characters.
+
+func (s *Storage) GetSharedTable(id string) (*models.Table, error) {
+	filename := filepath.Join(s.baseDir, tablesDir, fmt.Sprintf("%s.json", id))
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var table models.Table
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, err
+	}
+
+	return &table, nil
+}
+
+func (s *Storage) GetSharedTables() ([]*models.Table, error) {
+	return s.getSharedTablesFiltered(true)
+}
+
+func (s *Storage) GetDeletedSharedTables() ([]*models.Table, error) {
+	return s.getSharedTablesFiltered(false)
+}
+
+func (s *Storage) getSharedTablesFiltered(active bool) ([]*models.Table, error) {
+	dir := filepath.Join(s.baseDir, tablesDir)
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return []*models.Table{}, nil
+	}
+
+	var tables []*models.Table
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, file.Name()))
+		if err != nil {
+			continue
+		}
+
+		var table models.Table
+		if err := json.Unmarshal(data, &table); err != nil {
+			continue
+		}
+
+		if table.IsActive == active {
+			tables = append(tables, &table)
+		}
+	}
+
+	return tables, nil
+}
+
+func (s *Storage) SaveSharedTable(table *models.Table) error {
+	filename := filepath.Join(s.baseDir, tablesDir, fmt.Sprintf("%s.json", table.ID))
+
+	data, err := json.MarshalIndent(table, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, data, 0644)
+}
+
+func (s *Storage) DeleteSharedTable(id string) error {
+	table, err := s.GetSharedTable(id)
+	if err != nil {
+		return err
+	}
+
+	table.IsActive = false
+	return s.SaveSharedTable(table)
+}
+
+func (s *Storage) RestoreSharedTable(id string) error {
+	table, err := s.GetSharedTable(id)
+	if err != nil {
+		return err
+	}
+
+	table.IsActive = true
+	return s.SaveSharedTable(table)
+}
@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Transaction runs fn and, if it fails, puts the listed character and party
+// files back the way they were. Use it when one change has to update
+// several files together, such as moving an item between characters. If a
+// file can't be restored, the returned error wraps fn's error and says so.
+func (s *Storage) Transaction(characterIds []string, partyIds []string, fn func() error) error {
+	var paths []string
+	for _, id := range characterIds {
+		paths = append(paths, filepath.Join(s.baseDir, characterDir, fmt.Sprintf("%s.json", id)))
+	}
+	for _, id := range partyIds {
+		paths = append(paths, filepath.Join(s.baseDir, partiesDir, fmt.Sprintf("%s.json", id)))
+	}
+
+	snapshots := make(map[string][]byte)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		snapshots[path] = data
+	}
+
+	if err := fn(); err != nil {
+		var failed []string
+		for path, data := range snapshots {
+			var restoreErr error
+			if data == nil {
+				restoreErr = os.Remove(path)
+				if os.IsNotExist(restoreErr) {
+					restoreErr = nil
+				}
+			} else {
+				restoreErr = os.WriteFile(path, data, 0644)
+			}
+			if restoreErr != nil {
+				failed = append(failed, restoreErr.Error())
+			}
+		}
+		if len(failed) > 0 {
+			sort.Strings(failed)
+			return fmt.Errorf("%w (rollback failed: %s)", err, strings.Join(failed, "; "))
+		}
+		return err
+	}
+
+	return nil
+}